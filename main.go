@@ -2,72 +2,80 @@
 package main
 
 import (
+	"slices"
+
 	"github.com/terraform-linters/tflint-plugin-sdk/plugin"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
 	"github.com/terraform-linters/tflint-ruleset-azurerm-security/rules"
 )
 
-func createRuleSet() *tflint.BuiltinRuleSet {
-	return &tflint.BuiltinRuleSet{
+func createRuleSet() *RuleSet {
+	return &RuleSet{BuiltinRuleSet: tflint.BuiltinRuleSet{
 		Name:    "azurerm-security",
 		Version: project.Version,
-		Rules: []tflint.Rule{
-			rules.NewAzurermContainerGroupImageRegistryCredentialIdentity(),
-			rules.NewAzurermEventhubNamespaceNetworkSecurityPerimeterAssociation(),
-			rules.NewAzurermEventhubNamespacePublicNetworkAccessEnabled(),
-			rules.NewAzurermEventhubNamespaceUnsecureTLS(),
-			rules.NewAzurermIoTHubEndpointEventHubAuthenticationType(),
-			rules.NewAzureRmKeyVaultFeaturesRule(),
-			rules.NewAzurermKeyVaultNetworkSecurityPerimeterAssociation(),
-			rules.NewAzurermKeyVaultPublicNetworkAccessEnabled(),
-			rules.NewAzurermKeyVaultRbacDisabled(),
-			rules.NewAzurermKeyVaultCertificateLifetimeAction(),
-			rules.NewAzurermKeyVaultKeyRotationPolicy(),
-			rules.NewAzurermLinuxFunctionAppFtpsState(),
-			rules.NewAzurermLinuxFunctionAppHTTPSOnly(),
-			rules.NewAzurermLinuxFunctionAppMinimumTLSVersion(),
-			rules.NewAzurermLinuxFunctionAppScmIPRestrictionDefaultAction(),
-			rules.NewAzurermLinuxFunctionAppSlotFtpsState(),
-			rules.NewAzurermLinuxFunctionAppSlotHTTPSOnly(),
-			rules.NewAzurermLinuxFunctionAppSlotMinimumTLSVersion(),
-			rules.NewAzurermLinuxWebAppFtpsState(),
-			rules.NewAzurermLinuxWebAppHTTPSOnly(),
-			rules.NewAzurermLinuxWebAppMinimumTLSVersion(),
-			rules.NewAzurermLinuxWebAppScmIPRestrictionDefaultAction(),
-			rules.NewAzurermLinuxWebAppSlotFtpsState(),
-			rules.NewAzurermLinuxWebAppSlotHTTPSOnly(),
-			rules.NewAzurermLinuxWebAppSlotMinimumTLSVersion(),
-			rules.NewAzurermMssqlDatabaseEncryption(),
-			rules.NewAzurermMsSQLFirewallRuleAllAllowed(),
-			rules.NewAzurermMsSQLServerAdAuthOnly(),
-			rules.NewAzurermMsSQLServerPublicNetworkAccessEnabled(),
-			rules.NewAzurermMsSQLServerUnsecureTLS(),
-			rules.NewAzurermRedisCacheAADAuhtenticationEnabled(),
-			rules.NewAzurermRedisCacheMinimumTLSVersion(),
-			rules.NewAzurermRedisCacheNonSSLPortEnabled(),
-			rules.NewAzurermStorageAccountCrossTenantReplicationEnabled(),
-			rules.NewAzurermStorageAccountDefaultToOAuthAuthentication(),
-			rules.NewAzurermStorageAccountHTTPSTrafficOnlyEnabled(),
-			rules.NewAzurermStorageAccountNetworkSecurityPerimeterAssociation(),
-			rules.NewAzurermStorageAccountPublicNetworkAccessEnabled(),
-			rules.NewAzurermStorageAccountUnsecureTLS(),
-			rules.NewAzurermWindowsFunctionAppFtpsState(),
-			rules.NewAzurermWindowsFunctionAppHTTPSOnly(),
-			rules.NewAzurermWindowsFunctionAppMinimumTLSVersion(),
-			rules.NewAzurermWindowsFunctionAppScmIPRestrictionDefaultAction(),
-			rules.NewAzurermWindowsFunctionAppSlotFtpsState(),
-			rules.NewAzurermWindowsFunctionAppSlotHTTPSOnly(),
-			rules.NewAzurermWindowsFunctionAppSlotMinimumTLSVersion(),
-			rules.NewAzurermWindowsWebAppFtpsState(),
-			rules.NewAzurermWindowsWebAppHTTPSOnly(),
-			rules.NewAzurermWindowsWebAppMinimumTLSVersion(),
-			rules.NewAzurermWindowsWebAppScmIPRestrictionDefaultAction(),
-			rules.NewAzurermWindowsWebAppSlotFtpsState(),
-			rules.NewAzurermWindowsWebAppSlotHTTPSOnly(),
-			rules.NewAzurermWindowsWebAppSlotMinimumTLSVersion(),
-		},
-	}
+		Rules: slices.Concat(
+			[]tflint.Rule{
+				rules.NewAzurermContainerGroupImageRegistryCredentialIdentity(),
+				rules.NewAzurermContainerRegistryDataEndpointEnabled(),
+				rules.NewAzurermContainerRegistryPublicNetworkAccessEnabled(),
+				rules.NewAzurermCosmosdbAccountNetworkSecurityPerimeterAssociation(),
+				rules.NewAzurermCosmosdbAccountPublicNetworkAccessEnabled(),
+				rules.NewAzurermEventhubConnectionString(),
+				rules.NewAzurermEventhubNamespaceNetworkSecurityPerimeterAssociation(),
+				rules.NewAzurermEventhubNamespacePublicNetworkAccessEnabled(),
+				rules.NewAzurermEventhubNamespaceUnsecureTLS(),
+				rules.NewAzurermIoTHubEndpointAuthenticationType(),
+				rules.NewAzurermIoTHubEndpointEventHubAuthenticationType(),
+				rules.NewAzurermIoTHubPublicNetworkAccessEnabled(),
+				rules.NewAzurermKeyVaultCertificateLifetimeAction(),
+				rules.NewAzureRmKeyVaultFeaturesRule(),
+				rules.NewAzurermKeyVaultKeyRotationPolicy(),
+				rules.NewAzurermKeyVaultNetworkSecurityPerimeterAssociation(),
+				rules.NewAzurermKeyVaultPublicNetworkAccessEnabled(),
+				rules.NewAzurermKeyVaultRbacDisabled(),
+				rules.NewAzurermKubernetesClusterAPIServerAccess(),
+				rules.NewAzurermKubernetesClusterMonitoring(),
+				rules.NewAzurermLogAnalyticsWorkspaceNetworkSecurityPerimeterAssociation(),
+				rules.NewAzurermMssqlDatabaseEncryption(),
+				rules.NewAzurermMssqlDatabaseShortTermRetentionPolicy(),
+				rules.NewAzurermMsSQLServerAdAuthOnly(),
+				rules.NewAzurermMssqlServerExtendedAuditingPolicy(),
+				rules.NewAzurermMssqlServerNetworkSecurityPerimeterAssociation(),
+				rules.NewAzurermMsSQLServerPublicNetworkAccessEnabled(),
+				rules.NewAzurermMssqlServerSecurityAlertPolicy(),
+				rules.NewAzurermMsSQLServerUnsecureTLS(),
+				rules.NewAzurermMssqlServerVulnerabilityAssessment(),
+				rules.NewAzurermMysqlFlexibleServerActiveDirectoryAdministrator(),
+				rules.NewAzurermNetworkSecurityGroupOpenPorts(),
+				rules.NewAzurermNetworkSecurityRuleOpenPorts(),
+				rules.NewAzurermRedisCacheAADAuhtenticationEnabled(),
+				rules.NewAzurermRedisCacheMinimumTLSVersion(),
+				rules.NewAzurermRedisCacheNonSSLPortEnabled(),
+				rules.NewAzurermServicebusNamespaceNetworkSecurityPerimeterAssociation(),
+				rules.NewAzurermServicebusNamespacePublicNetworkAccessEnabled(),
+				rules.NewAzurermStorageAccountCrossTenantReplicationEnabled(),
+				rules.NewAzurermStorageAccountDefaultToOAuthAuthentication(),
+				rules.NewAzurermStorageAccountHTTPSTrafficOnlyEnabled(),
+				rules.NewAzurermStorageAccountNetworkSecurityPerimeterAssociation(),
+				rules.NewAzurermStorageAccountPublicNetworkAccessEnabled(),
+				rules.NewAzurermStorageAccountQueuePropertiesLogging(),
+				rules.NewAzurermStorageAccountSASPolicy(),
+				rules.NewAzurermStorageAccountUnsecureTLS(),
+			},
+			rules.NewAttributeRules(),
+			rules.NewAVMModuleInputsRules(),
+			rules.NewContainerRegistryPolicyRules(),
+			rules.NewCustomerManagedKeyRules(),
+			rules.NewDiagnosticSettingRules(),
+			rules.NewFirewallRuleAllAllowedRules(),
+			rules.NewFlexibleServerConfigurationRules(),
+			rules.NewFlexibleServerPublicNetworkAccessRules(),
+			rules.NewIPRulesRules(),
+			rules.NewPrivateEndpointRules(),
+			rules.NewStorageAccountDeleteRetentionPolicyRules(),
+		),
+	}}
 }
 
 func main() {
@@ -8,38 +8,52 @@ import (
 	"path/filepath"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 	"testing"
 
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/rules"
 )
 
 func TestRulesLength(t *testing.T) {
 	ruleSet := createRuleSet()
 	actualRules := len(ruleSet.Rules)
 
-	// Count .go files in rules directory that don't end with _test.go
-	rulesPath := "./rules"
+	// Every rule registered once, and documented in docs/rules
+	ruleNames := map[string]bool{}
+	for _, rule := range ruleSet.Rules {
+		if ruleNames[rule.Name()] {
+			t.Errorf("Rule %s is registered more than once", rule.Name())
+		}
+		ruleNames[rule.Name()] = true
+	}
+
+	// Count rule documentation files in docs/rules, except the template
+	docsPath := "./docs/rules"
 	expectedRules := 0
 
-	err := filepath.Walk(rulesPath, func(path string, info os.FileInfo, err error) error {
+	err := filepath.Walk(docsPath, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
-		if !info.IsDir() && 
-		   strings.HasSuffix(path, ".go") && 
-		   !strings.HasSuffix(path, "_test.go") {
-			expectedRules++
+		if info.IsDir() || !strings.HasSuffix(path, ".md") || info.Name() == "template.md" {
+			return nil
+		}
+		expectedRules++
+		if name := strings.TrimSuffix(info.Name(), ".md"); !ruleNames[name] {
+			t.Errorf("Rule %s is documented but not registered", name)
 		}
 		return nil
 	})
 
 	if err != nil {
-		t.Fatalf("Error walking rules directory: %v", err)
+		t.Fatalf("Error walking rules documentation directory: %v", err)
 	}
 
 	if actualRules != expectedRules {
-		t.Errorf("Number of rules does not match number of rule files. Got %d rules, expected %d", 
+		t.Errorf("Number of rules does not match number of documented rules. Got %d rules, expected %d",
 			actualRules, expectedRules)
 	}
 }
@@ -75,6 +89,11 @@ func TestRuleDocumentation(t *testing.T) {
 		ruleName := rule.Name()
 		docPath := filepath.Join(docsDir, ruleName+".md")
 
+		// Every rule must be mapped to the compliance frameworks
+		if _, ok := project.ComplianceControls(ruleName); !ok {
+			t.Errorf("Rule %s has no compliance mapping in project/compliance.go", ruleName)
+		}
+
 		// Check if documentation file exists
 		fileInfo, err := os.Stat(docPath)
 		if os.IsNotExist(err) {
@@ -220,6 +239,61 @@ func TestRulesReadme(t *testing.T) {
 	}
 	content.WriteString("\n")
 
+	// Add security profiles table
+	content.WriteString("## Profiles\n\n")
+	content.WriteString("|Profile|Description|Extends|Minimum TLS version|Enables|Severities|\n")
+	content.WriteString("| --- | --- | --- | --- | --- | --- |\n")
+	for _, profile := range rules.Profiles {
+		enables := []string{}
+		if profile.EnableDisabledRules {
+			enables = append(enables, "rules disabled by default")
+		}
+		for _, pattern := range profile.Enable {
+			enables = append(enables, fmt.Sprintf("`%s`", pattern))
+		}
+		severities := []string{}
+		for _, from := range []tflint.Severity{tflint.NOTICE, tflint.WARNING, tflint.ERROR} {
+			if to, ok := profile.Severities[from]; ok {
+				severities = append(severities, fmt.Sprintf("%s → %s", from, to))
+			}
+		}
+		content.WriteString(fmt.Sprintf("|%s|%s|%s|%s|%s|%s|\n",
+			profile.Name,
+			profile.Description,
+			profile.Extends,
+			profile.TLSVersion(),
+			strings.Join(enables, ", "),
+			strings.Join(severities, ", ")))
+	}
+	content.WriteString("\n")
+
+	// Add compliance coverage tables
+	content.WriteString("## Compliance\n\n")
+	for _, framework := range project.Frameworks {
+		rulesByControl := map[string][]string{}
+		for _, rule := range allRules {
+			controls, _ := project.ComplianceControls(rule.Name())
+			for _, id := range controls.Of(framework.ID) {
+				rulesByControl[id] = append(rulesByControl[id], fmt.Sprintf("[%s](./rules/%s.md)", rule.Name(), rule.Name()))
+			}
+		}
+		var ids []string
+		for id := range rulesByControl {
+			ids = append(ids, id)
+		}
+		sort.Slice(ids, func(i, j int) bool {
+			return controlLess(ids[i], ids[j])
+		})
+
+		content.WriteString(fmt.Sprintf("### %s\n\n", framework.Name))
+		content.WriteString("|Control|Rules|\n")
+		content.WriteString("| --- | --- |\n")
+		for _, id := range ids {
+			content.WriteString(fmt.Sprintf("|%s|%s|\n", id, strings.Join(rulesByControl[id], "<br>")))
+		}
+		content.WriteString("\n")
+	}
+
 	// Add resource type sections
 	content.WriteString("## Rules by Resource\n\n")
 	for _, resourceType := range resourceTypes {
@@ -256,6 +330,8 @@ func TestRulesReadme(t *testing.T) {
 	requiredSections := []string{
 		"# Rules",
 		"## Rules Index",
+		"## Profiles",
+		"## Compliance",
 		"## Rules by Resource",
 	}
 
@@ -284,4 +360,24 @@ func TestRulesReadme(t *testing.T) {
 			t.Errorf("README.md is missing resource type section: %s", resourceType)
 		}
 	}
-}
\ No newline at end of file
+}
+
+// controlLess orders control IDs by their numeric parts, so that 3.7 sorts before 3.15 and SC-8 before SC-13
+func controlLess(a, b string) bool {
+	split := func(id string) []string {
+		return strings.FieldsFunc(id, func(r rune) bool { return r == '.' || r == '-' || r == '(' || r == ')' })
+	}
+	aParts, bParts := split(a), split(b)
+	for i := 0; i < len(aParts) && i < len(bParts); i++ {
+		if aParts[i] == bParts[i] {
+			continue
+		}
+		aNumber, aErr := strconv.Atoi(aParts[i])
+		bNumber, bErr := strconv.Atoi(bParts[i])
+		if aErr == nil && bErr == nil {
+			return aNumber < bNumber
+		}
+		return aParts[i] < bParts[i]
+	}
+	return len(aParts) < len(bParts)
+}
@@ -0,0 +1,226 @@
+package project
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Framework is a compliance framework whose controls are mapped to the rules
+type Framework struct {
+	ID   string
+	Name string
+}
+
+// Frameworks are the compliance frameworks of the control mappings, in reporting order
+var Frameworks = []Framework{
+	{ID: "CIS", Name: "CIS Microsoft Azure Foundations Benchmark v2.1.0"},
+	{ID: "MCSB", Name: "Microsoft Cloud Security Benchmark v1"},
+	{ID: "NIST", Name: "NIST SP 800-53 Rev. 5"},
+}
+
+// Controls are the control IDs a rule maps to in every framework.
+// A framework without a matching control is left empty.
+type Controls struct {
+	CIS  []string
+	MCSB []string
+	NIST []string
+}
+
+// Of returns the control IDs of the framework with the given ID
+func (c Controls) Of(framework string) []string {
+	switch framework {
+	case "CIS":
+		return c.CIS
+	case "MCSB":
+		return c.MCSB
+	case "NIST":
+		return c.NIST
+	default:
+		return nil
+	}
+}
+
+// String returns the control IDs grouped by framework, e.g. "CIS 9.2; MCSB DP-3; NIST SC-8"
+func (c Controls) String() string {
+	groups := []string{}
+	for _, framework := range Frameworks {
+		if ids := c.Of(framework.ID); len(ids) > 0 {
+			groups = append(groups, fmt.Sprintf("%s %s", framework.ID, strings.Join(ids, ", ")))
+		}
+	}
+	return strings.Join(groups, "; ")
+}
+
+// compliance maps every rule name to its controls
+var compliance = map[string]Controls{
+	"azurerm_container_group_image_registry_credential_identity":             {MCSB: []string{"IM-3"}, NIST: []string{"IA-2", "IA-5"}},
+	"azurerm_container_registry_admin_enabled":                               {MCSB: []string{"IM-1"}, NIST: []string{"AC-2", "IA-2"}},
+	"azurerm_container_registry_anonymous_pull_enabled":                      {MCSB: []string{"PA-7"}, NIST: []string{"AC-3", "AC-14"}},
+	"azurerm_container_registry_customer_managed_key":                        {MCSB: []string{"DP-5"}, NIST: []string{"SC-12", "SC-28"}},
+	"azurerm_container_registry_data_endpoint_enabled":                       {MCSB: []string{"NS-2"}, NIST: []string{"AC-4", "SC-7"}},
+	"azurerm_container_registry_export_policy_enabled":                       {MCSB: []string{"DP-2"}, NIST: []string{"AC-4", "SC-7(10)"}},
+	"azurerm_container_registry_private_endpoint":                            {MCSB: []string{"NS-2"}, NIST: []string{"AC-4", "SC-7"}},
+	"azurerm_container_registry_public_network_access_enabled":               {MCSB: []string{"NS-2"}, NIST: []string{"AC-4", "SC-7"}},
+	"azurerm_container_registry_quarantine_policy_enabled":                   {MCSB: []string{"DS-6"}, NIST: []string{"SI-7"}},
+	"azurerm_container_registry_retention_policy":                            {MCSB: []string{"DS-6"}, NIST: []string{"SI-12"}},
+	"azurerm_container_registry_trust_policy":                                {MCSB: []string{"DS-6"}, NIST: []string{"CM-14", "SI-7"}},
+	"azurerm_cosmosdb_account_access_key_metadata_writes_enabled":            {MCSB: []string{"PA-7"}, NIST: []string{"AC-3", "CM-5"}},
+	"azurerm_cosmosdb_account_backup_type":                                   {MCSB: []string{"BR-1"}, NIST: []string{"CP-9"}},
+	"azurerm_cosmosdb_account_customer_managed_key":                          {MCSB: []string{"DP-5"}, NIST: []string{"SC-12", "SC-28"}},
+	"azurerm_cosmosdb_account_ip_range_filter":                               {CIS: []string{"4.5.1"}, MCSB: []string{"NS-2"}, NIST: []string{"AC-3", "SC-7"}},
+	"azurerm_cosmosdb_account_local_authentication_disabled":                 {CIS: []string{"4.5.3"}, MCSB: []string{"IM-1"}, NIST: []string{"AC-2", "IA-2"}},
+	"azurerm_cosmosdb_account_minimal_tls_version":                           {MCSB: []string{"DP-3"}, NIST: []string{"SC-8", "SC-13"}},
+	"azurerm_cosmosdb_account_network_security_perimeter_association":        {MCSB: []string{"NS-2"}, NIST: []string{"SC-7"}},
+	"azurerm_cosmosdb_account_private_endpoint":                              {CIS: []string{"4.5.2"}, MCSB: []string{"NS-2"}, NIST: []string{"AC-4", "SC-7"}},
+	"azurerm_cosmosdb_account_public_network_access_enabled":                 {CIS: []string{"4.5.1"}, MCSB: []string{"NS-2"}, NIST: []string{"AC-4", "SC-7"}},
+	"azurerm_eventhub_authorization_rule_manage":                             {MCSB: []string{"PA-7"}, NIST: []string{"AC-6"}},
+	"azurerm_eventhub_connection_string":                                     {MCSB: []string{"IM-3", "IM-8"}, NIST: []string{"IA-5", "SC-28"}},
+	"azurerm_eventhub_namespace_authorization_rule_manage":                   {MCSB: []string{"PA-7"}, NIST: []string{"AC-6"}},
+	"azurerm_eventhub_namespace_avm_module_inputs":                           {MCSB: []string{"DP-3", "NS-2"}, NIST: []string{"SC-7", "SC-8"}},
+	"azurerm_eventhub_namespace_customer_managed_key":                        {MCSB: []string{"DP-5"}, NIST: []string{"SC-12", "SC-28"}},
+	"azurerm_eventhub_namespace_diagnostic_setting":                          {MCSB: []string{"LT-3"}, NIST: []string{"AU-2", "AU-12"}},
+	"azurerm_eventhub_namespace_local_authentication_enabled":                {MCSB: []string{"IM-1"}, NIST: []string{"AC-2", "IA-2"}},
+	"azurerm_eventhub_namespace_network_security_perimeter_association":      {MCSB: []string{"NS-2"}, NIST: []string{"SC-7"}},
+	"azurerm_eventhub_namespace_private_endpoint":                            {MCSB: []string{"NS-2"}, NIST: []string{"AC-4", "SC-7"}},
+	"azurerm_eventhub_namespace_public_network_access_enabled":               {MCSB: []string{"NS-2"}, NIST: []string{"AC-17", "SC-7"}},
+	"azurerm_eventhub_namespace_unsecure_tls":                                {MCSB: []string{"DP-3", "NS-8"}, NIST: []string{"SC-8", "SC-13"}},
+	"azurerm_iothub_endpoint_authentication_type":                            {MCSB: []string{"IM-3"}, NIST: []string{"IA-5"}},
+	"azurerm_iothub_endpoint_eventhub_authentication_type":                   {MCSB: []string{"IM-3"}, NIST: []string{"IA-5"}},
+	"azurerm_iothub_endpoint_servicebus_queue_authentication_type":           {MCSB: []string{"IM-3"}, NIST: []string{"IA-5"}},
+	"azurerm_iothub_endpoint_servicebus_topic_authentication_type":           {MCSB: []string{"IM-3"}, NIST: []string{"IA-5"}},
+	"azurerm_iothub_endpoint_storage_container_authentication_type":          {MCSB: []string{"IM-3"}, NIST: []string{"IA-5"}},
+	"azurerm_iothub_local_authentication_enabled":                            {MCSB: []string{"IM-1"}, NIST: []string{"AC-2", "IA-2"}},
+	"azurerm_iothub_min_tls_version":                                         {MCSB: []string{"DP-3", "NS-8"}, NIST: []string{"SC-8", "SC-13"}},
+	"azurerm_iothub_public_network_access_enabled":                           {MCSB: []string{"NS-2"}, NIST: []string{"AC-17", "SC-7"}},
+	"azurerm_key_vault_avm_module_inputs":                                    {CIS: []string{"8.6"}, MCSB: []string{"NS-2", "PA-7"}, NIST: []string{"AC-3", "SC-7"}},
+	"azurerm_key_vault_certificate_lifetime_action":                          {MCSB: []string{"DP-7"}, NIST: []string{"SC-12", "SC-17"}},
+	"azurerm_key_vault_diagnostic_setting":                                   {CIS: []string{"5.1.5"}, MCSB: []string{"LT-3"}, NIST: []string{"AU-2", "AU-12"}},
+	"azurerm_key_vault_enable_rbac_authorization":                            {CIS: []string{"8.6"}, MCSB: []string{"PA-7"}, NIST: []string{"AC-3", "AC-6"}},
+	"azurerm_key_vault_ip_rules":                                             {MCSB: []string{"NS-2"}, NIST: []string{"AC-3", "SC-7"}},
+	"azurerm_key_vault_key_rotation_policy":                                  {CIS: []string{"8.8"}, MCSB: []string{"DP-6"}, NIST: []string{"SC-12"}},
+	"azurerm_key_vault_network_security_perimeter_association":               {MCSB: []string{"NS-2"}, NIST: []string{"SC-7"}},
+	"azurerm_key_vault_private_endpoint":                                     {CIS: []string{"8.7"}, MCSB: []string{"NS-2"}, NIST: []string{"AC-4", "SC-7"}},
+	"azurerm_key_vault_public_network_access_enabled":                        {CIS: []string{"8.7"}, MCSB: []string{"NS-2"}, NIST: []string{"AC-17", "SC-7"}},
+	"azurerm_keyvault_features_check":                                        {CIS: []string{"8.5"}, MCSB: []string{"DP-8"}, NIST: []string{"CP-9", "SC-12"}},
+	"azurerm_kubernetes_cluster_api_server_access":                           {MCSB: []string{"NS-2"}, NIST: []string{"AC-17", "SC-7"}},
+	"azurerm_kubernetes_cluster_automatic_upgrade_channel":                   {MCSB: []string{"PV-6"}, NIST: []string{"SI-2"}},
+	"azurerm_kubernetes_cluster_azure_policy_enabled":                        {MCSB: []string{"PV-2"}, NIST: []string{"CM-2", "CM-6"}},
+	"azurerm_kubernetes_cluster_azure_rbac_enabled":                          {MCSB: []string{"PA-7"}, NIST: []string{"AC-3", "AC-6"}},
+	"azurerm_kubernetes_cluster_customer_managed_key":                        {MCSB: []string{"DP-5"}, NIST: []string{"SC-12", "SC-28"}},
+	"azurerm_kubernetes_cluster_local_account_disabled":                      {MCSB: []string{"IM-1"}, NIST: []string{"AC-2", "IA-2"}},
+	"azurerm_kubernetes_cluster_monitoring":                                  {MCSB: []string{"LT-1", "LT-3"}, NIST: []string{"AU-2", "AU-12", "SI-4"}},
+	"azurerm_kubernetes_cluster_network_policy":                              {MCSB: []string{"NS-1"}, NIST: []string{"AC-4", "SC-7"}},
+	"azurerm_kubernetes_cluster_oidc_issuer_enabled":                         {MCSB: []string{"IM-3"}, NIST: []string{"IA-5"}},
+	"azurerm_kubernetes_cluster_run_command_enabled":                         {MCSB: []string{"PA-7"}, NIST: []string{"AC-6", "CM-7"}},
+	"azurerm_kubernetes_cluster_workload_identity_enabled":                   {MCSB: []string{"IM-3"}, NIST: []string{"IA-5"}},
+	"azurerm_linux_function_app_diagnostic_setting":                          {MCSB: []string{"LT-3"}, NIST: []string{"AU-2", "AU-12"}},
+	"azurerm_linux_function_app_ftps_state":                                  {CIS: []string{"9.10"}, MCSB: []string{"DP-3", "NS-8"}, NIST: []string{"CM-7", "SC-8"}},
+	"azurerm_linux_function_app_https_only":                                  {CIS: []string{"9.2"}, MCSB: []string{"DP-3"}, NIST: []string{"SC-8", "SC-8(1)"}},
+	"azurerm_linux_function_app_minimum_tls_version":                         {CIS: []string{"9.3"}, MCSB: []string{"DP-3", "NS-8"}, NIST: []string{"SC-8", "SC-13"}},
+	"azurerm_linux_function_app_scm_ip_restriction_default_action":           {MCSB: []string{"NS-2"}, NIST: []string{"AC-17", "SC-7"}},
+	"azurerm_linux_function_app_slot_ftps_state":                             {CIS: []string{"9.10"}, MCSB: []string{"DP-3", "NS-8"}, NIST: []string{"CM-7", "SC-8"}},
+	"azurerm_linux_function_app_slot_https_only":                             {CIS: []string{"9.2"}, MCSB: []string{"DP-3"}, NIST: []string{"SC-8", "SC-8(1)"}},
+	"azurerm_linux_function_app_slot_minimum_tls_version":                    {CIS: []string{"9.3"}, MCSB: []string{"DP-3", "NS-8"}, NIST: []string{"SC-8", "SC-13"}},
+	"azurerm_linux_web_app_diagnostic_setting":                               {MCSB: []string{"LT-3"}, NIST: []string{"AU-2", "AU-12"}},
+	"azurerm_linux_web_app_ftps_state":                                       {CIS: []string{"9.10"}, MCSB: []string{"DP-3", "NS-8"}, NIST: []string{"CM-7", "SC-8"}},
+	"azurerm_linux_web_app_https_only":                                       {CIS: []string{"9.2"}, MCSB: []string{"DP-3"}, NIST: []string{"SC-8", "SC-8(1)"}},
+	"azurerm_linux_web_app_minimum_tls_version":                              {CIS: []string{"9.3"}, MCSB: []string{"DP-3", "NS-8"}, NIST: []string{"SC-8", "SC-13"}},
+	"azurerm_linux_web_app_private_endpoint":                                 {MCSB: []string{"NS-2"}, NIST: []string{"AC-4", "SC-7"}},
+	"azurerm_linux_web_app_scm_ip_restriction_default_action":                {MCSB: []string{"NS-2"}, NIST: []string{"AC-17", "SC-7"}},
+	"azurerm_linux_web_app_slot_ftps_state":                                  {CIS: []string{"9.10"}, MCSB: []string{"DP-3", "NS-8"}, NIST: []string{"CM-7", "SC-8"}},
+	"azurerm_linux_web_app_slot_https_only":                                  {CIS: []string{"9.2"}, MCSB: []string{"DP-3"}, NIST: []string{"SC-8", "SC-8(1)"}},
+	"azurerm_linux_web_app_slot_minimum_tls_version":                         {CIS: []string{"9.3"}, MCSB: []string{"DP-3", "NS-8"}, NIST: []string{"SC-8", "SC-13"}},
+	"azurerm_log_analytics_workspace_network_security_perimeter_association": {MCSB: []string{"NS-2"}, NIST: []string{"SC-7"}},
+	"azurerm_managed_disk_customer_managed_key":                              {MCSB: []string{"DP-5"}, NIST: []string{"SC-12", "SC-28"}},
+	"azurerm_mssql_database_encryption":                                      {CIS: []string{"4.1.5"}, MCSB: []string{"DP-4"}, NIST: []string{"SC-28", "SC-28(1)"}},
+	"azurerm_mssql_database_ledger_enabled":                                  {NIST: []string{"AU-9", "SI-7"}},
+	"azurerm_mssql_database_short_term_retention_policy":                     {MCSB: []string{"BR-1"}, NIST: []string{"CP-9"}},
+	"azurerm_mssql_firewall_rule_all_allowed":                                {CIS: []string{"4.1.2"}, MCSB: []string{"NS-2"}, NIST: []string{"AC-3", "SC-7"}},
+	"azurerm_mssql_server_avm_module_inputs":                                 {MCSB: []string{"NS-2"}, NIST: []string{"SC-7"}},
+	"azurerm_mssql_server_azuread_authentication_only":                       {CIS: []string{"4.1.4"}, MCSB: []string{"IM-1"}, NIST: []string{"AC-2", "IA-2"}},
+	"azurerm_mssql_server_customer_managed_key":                              {CIS: []string{"4.1.3"}, MCSB: []string{"DP-5"}, NIST: []string{"SC-12", "SC-28"}},
+	"azurerm_mssql_server_diagnostic_setting":                                {CIS: []string{"4.1.1"}, MCSB: []string{"LT-3"}, NIST: []string{"AU-2", "AU-12"}},
+	"azurerm_mssql_server_extended_auditing_policy":                          {CIS: []string{"4.1.1", "4.1.6"}, MCSB: []string{"LT-3", "LT-6"}, NIST: []string{"AU-2", "AU-11", "AU-12"}},
+	"azurerm_mssql_server_network_security_perimeter_association":            {MCSB: []string{"NS-2"}, NIST: []string{"SC-7"}},
+	"azurerm_mssql_server_private_endpoint":                                  {MCSB: []string{"NS-2"}, NIST: []string{"AC-4", "SC-7"}},
+	"azurerm_mssql_server_public_network_access_enabled":                     {MCSB: []string{"NS-2"}, NIST: []string{"AC-17", "SC-7"}},
+	"azurerm_mssql_server_security_alert_policy":                             {CIS: []string{"4.2.1"}, MCSB: []string{"LT-1"}, NIST: []string{"SI-4"}},
+	"azurerm_mssql_server_unsecure_tls":                                      {MCSB: []string{"DP-3", "NS-8"}, NIST: []string{"SC-8", "SC-13"}},
+	"azurerm_mssql_server_vulnerability_assessment":                          {CIS: []string{"4.2.2"}, MCSB: []string{"PV-5"}, NIST: []string{"RA-5"}},
+	"azurerm_mysql_flexible_server_aad_auth_only":                            {MCSB: []string{"IM-1"}, NIST: []string{"AC-2", "IA-2"}},
+	"azurerm_mysql_flexible_server_active_directory_administrator":           {MCSB: []string{"IM-1"}, NIST: []string{"AC-2", "IA-2"}},
+	"azurerm_mysql_flexible_server_customer_managed_key":                     {MCSB: []string{"DP-5"}, NIST: []string{"SC-12", "SC-28"}},
+	"azurerm_mysql_flexible_server_firewall_rule_all_allowed":                {MCSB: []string{"NS-2"}, NIST: []string{"AC-3", "SC-7"}},
+	"azurerm_mysql_flexible_server_geo_redundant_backup_enabled":             {MCSB: []string{"BR-1"}, NIST: []string{"CP-6", "CP-9"}},
+	"azurerm_mysql_flexible_server_public_network_access_enabled":            {MCSB: []string{"NS-2"}, NIST: []string{"AC-4", "SC-7"}},
+	"azurerm_mysql_flexible_server_require_secure_transport":                 {CIS: []string{"4.4.1"}, MCSB: []string{"DP-3"}, NIST: []string{"SC-8", "SC-8(1)"}},
+	"azurerm_mysql_flexible_server_tls_version":                              {CIS: []string{"4.4.2"}, MCSB: []string{"DP-3"}, NIST: []string{"SC-8", "SC-13"}},
+	"azurerm_network_security_group_diagnostic_setting":                      {MCSB: []string{"LT-3"}, NIST: []string{"AU-2", "AU-12"}},
+	"azurerm_network_security_group_open_ports":                              {CIS: []string{"6.1", "6.2"}, MCSB: []string{"NS-1"}, NIST: []string{"AC-4", "CM-7", "SC-7"}},
+	"azurerm_network_security_rule_open_ports":                               {CIS: []string{"6.1", "6.2"}, MCSB: []string{"NS-1"}, NIST: []string{"AC-4", "CM-7", "SC-7"}},
+	"azurerm_postgresql_flexible_server_active_directory_auth_enabled":       {MCSB: []string{"IM-1"}, NIST: []string{"AC-2", "IA-2"}},
+	"azurerm_postgresql_flexible_server_customer_managed_key":                {MCSB: []string{"DP-5"}, NIST: []string{"SC-12", "SC-28"}},
+	"azurerm_postgresql_flexible_server_firewall_rule_all_allowed":           {CIS: []string{"4.3.7"}, MCSB: []string{"NS-2"}, NIST: []string{"AC-3", "SC-7"}},
+	"azurerm_postgresql_flexible_server_geo_redundant_backup_enabled":        {MCSB: []string{"BR-1"}, NIST: []string{"CP-6", "CP-9"}},
+	"azurerm_postgresql_flexible_server_password_auth_enabled":               {MCSB: []string{"IM-1"}, NIST: []string{"AC-2", "IA-2", "IA-5"}},
+	"azurerm_postgresql_flexible_server_public_network_access_enabled":       {MCSB: []string{"NS-2"}, NIST: []string{"AC-4", "SC-7"}},
+	"azurerm_postgresql_flexible_server_require_secure_transport":            {CIS: []string{"4.3.1"}, MCSB: []string{"DP-3"}, NIST: []string{"SC-8", "SC-8(1)"}},
+	"azurerm_postgresql_flexible_server_ssl_min_protocol_version":            {MCSB: []string{"DP-3"}, NIST: []string{"SC-8", "SC-13"}},
+	"azurerm_redis_cache_active_directory_authentication_enabled":            {MCSB: []string{"IM-1"}, NIST: []string{"IA-2"}},
+	"azurerm_redis_cache_avm_module_inputs":                                  {MCSB: []string{"DP-3", "NS-2"}, NIST: []string{"SC-7", "SC-8"}},
+	"azurerm_redis_cache_minimum_tls_version":                                {MCSB: []string{"DP-3", "NS-8"}, NIST: []string{"SC-8", "SC-13"}},
+	"azurerm_redis_cache_non_ssl_port_enabled":                               {MCSB: []string{"DP-3"}, NIST: []string{"SC-8"}},
+	"azurerm_servicebus_namespace_authorization_rule_manage":                 {MCSB: []string{"PA-7"}, NIST: []string{"AC-6"}},
+	"azurerm_servicebus_namespace_customer_managed_key":                      {MCSB: []string{"DP-5"}, NIST: []string{"SC-12", "SC-28"}},
+	"azurerm_servicebus_namespace_local_auth_enabled":                        {MCSB: []string{"IM-1"}, NIST: []string{"AC-2", "IA-2"}},
+	"azurerm_servicebus_namespace_minimum_tls_version":                       {MCSB: []string{"DP-3", "NS-8"}, NIST: []string{"SC-8", "SC-13"}},
+	"azurerm_servicebus_namespace_network_security_perimeter_association":    {MCSB: []string{"NS-2"}, NIST: []string{"SC-7"}},
+	"azurerm_servicebus_namespace_private_endpoint":                          {MCSB: []string{"NS-2"}, NIST: []string{"AC-4", "SC-7"}},
+	"azurerm_servicebus_namespace_public_network_access_enabled":             {MCSB: []string{"NS-2"}, NIST: []string{"AC-17", "SC-7"}},
+	"azurerm_servicebus_queue_authorization_rule_manage":                     {MCSB: []string{"PA-7"}, NIST: []string{"AC-6"}},
+	"azurerm_servicebus_topic_authorization_rule_manage":                     {MCSB: []string{"PA-7"}, NIST: []string{"AC-6"}},
+	"azurerm_storage_account_allow_nested_items_to_be_public":                {CIS: []string{"3.17"}, MCSB: []string{"DP-2"}, NIST: []string{"AC-3", "AC-14"}},
+	"azurerm_storage_account_avm_module_inputs":                              {CIS: []string{"3.1", "3.7", "3.8", "3.15"}, MCSB: []string{"DP-3", "NS-2"}, NIST: []string{"SC-7", "SC-8"}},
+	"azurerm_storage_account_blob_delete_retention_policy":                   {CIS: []string{"3.11"}, MCSB: []string{"BR-1"}, NIST: []string{"CP-9"}},
+	"azurerm_storage_account_container_delete_retention_policy":              {CIS: []string{"3.11"}, MCSB: []string{"BR-1"}, NIST: []string{"CP-9"}},
+	"azurerm_storage_account_cross_tenant_replication_enabled":               {CIS: []string{"3.16"}, MCSB: []string{"DP-2"}, NIST: []string{"AC-4"}},
+	"azurerm_storage_account_customer_managed_key":                           {CIS: []string{"3.12"}, MCSB: []string{"DP-5"}, NIST: []string{"SC-12", "SC-28"}},
+	"azurerm_storage_account_default_to_oauth_authentication":                {MCSB: []string{"IM-1"}, NIST: []string{"IA-2"}},
+	"azurerm_storage_account_diagnostic_setting":                             {CIS: []string{"3.13"}, MCSB: []string{"LT-3"}, NIST: []string{"AU-2", "AU-12"}},
+	"azurerm_storage_account_https_traffic_only_enabled":                     {CIS: []string{"3.1"}, MCSB: []string{"DP-3"}, NIST: []string{"SC-8", "SC-8(1)"}},
+	"azurerm_storage_account_infrastructure_encryption_enabled":              {CIS: []string{"3.2"}, MCSB: []string{"DP-4"}, NIST: []string{"SC-28", "SC-28(1)"}},
+	"azurerm_storage_account_ip_rules":                                       {CIS: []string{"3.8"}, MCSB: []string{"NS-2"}, NIST: []string{"AC-3", "SC-7"}},
+	"azurerm_storage_account_network_security_perimeter_association":         {MCSB: []string{"NS-2"}, NIST: []string{"SC-7"}},
+	"azurerm_storage_account_private_endpoint":                               {CIS: []string{"3.10"}, MCSB: []string{"NS-2"}, NIST: []string{"AC-4", "SC-7"}},
+	"azurerm_storage_account_public_network_access_enabled":                  {CIS: []string{"3.7"}, MCSB: []string{"NS-2"}, NIST: []string{"AC-17", "SC-7"}},
+	"azurerm_storage_account_queue_properties_logging":                       {CIS: []string{"3.5"}, MCSB: []string{"LT-3"}, NIST: []string{"AU-2", "AU-12"}},
+	"azurerm_storage_account_sas_policy":                                     {CIS: []string{"3.6"}, MCSB: []string{"IM-3"}, NIST: []string{"AC-2", "IA-5"}},
+	"azurerm_storage_account_shared_access_key_enabled":                      {MCSB: []string{"IM-1"}, NIST: []string{"AC-2", "IA-2"}},
+	"azurerm_storage_account_unsecure_tls":                                   {CIS: []string{"3.15"}, MCSB: []string{"DP-3", "NS-8"}, NIST: []string{"SC-8", "SC-13"}},
+	"azurerm_storage_account_versioning_enabled":                             {MCSB: []string{"BR-1"}, NIST: []string{"CP-9"}},
+	"azurerm_storage_container_container_access_type":                        {CIS: []string{"3.17"}, MCSB: []string{"DP-2"}, NIST: []string{"AC-3", "AC-14"}},
+	"azurerm_synapse_firewall_rule_all_allowed":                              {MCSB: []string{"NS-2"}, NIST: []string{"AC-3", "SC-7"}},
+	"azurerm_windows_function_app_diagnostic_setting":                        {MCSB: []string{"LT-3"}, NIST: []string{"AU-2", "AU-12"}},
+	"azurerm_windows_function_app_ftps_state":                                {CIS: []string{"9.10"}, MCSB: []string{"DP-3", "NS-8"}, NIST: []string{"CM-7", "SC-8"}},
+	"azurerm_windows_function_app_https_only":                                {CIS: []string{"9.2"}, MCSB: []string{"DP-3"}, NIST: []string{"SC-8", "SC-8(1)"}},
+	"azurerm_windows_function_app_minimum_tls_version":                       {CIS: []string{"9.3"}, MCSB: []string{"DP-3", "NS-8"}, NIST: []string{"SC-8", "SC-13"}},
+	"azurerm_windows_function_app_scm_ip_restriction_default_action":         {MCSB: []string{"NS-2"}, NIST: []string{"AC-17", "SC-7"}},
+	"azurerm_windows_function_app_slot_ftps_state":                           {CIS: []string{"9.10"}, MCSB: []string{"DP-3", "NS-8"}, NIST: []string{"CM-7", "SC-8"}},
+	"azurerm_windows_function_app_slot_https_only":                           {CIS: []string{"9.2"}, MCSB: []string{"DP-3"}, NIST: []string{"SC-8", "SC-8(1)"}},
+	"azurerm_windows_function_app_slot_minimum_tls_version":                  {CIS: []string{"9.3"}, MCSB: []string{"DP-3", "NS-8"}, NIST: []string{"SC-8", "SC-13"}},
+	"azurerm_windows_web_app_diagnostic_setting":                             {MCSB: []string{"LT-3"}, NIST: []string{"AU-2", "AU-12"}},
+	"azurerm_windows_web_app_ftps_state":                                     {CIS: []string{"9.10"}, MCSB: []string{"DP-3", "NS-8"}, NIST: []string{"CM-7", "SC-8"}},
+	"azurerm_windows_web_app_https_only":                                     {CIS: []string{"9.2"}, MCSB: []string{"DP-3"}, NIST: []string{"SC-8", "SC-8(1)"}},
+	"azurerm_windows_web_app_minimum_tls_version":                            {CIS: []string{"9.3"}, MCSB: []string{"DP-3", "NS-8"}, NIST: []string{"SC-8", "SC-13"}},
+	"azurerm_windows_web_app_private_endpoint":                               {MCSB: []string{"NS-2"}, NIST: []string{"AC-4", "SC-7"}},
+	"azurerm_windows_web_app_scm_ip_restriction_default_action":              {MCSB: []string{"NS-2"}, NIST: []string{"AC-17", "SC-7"}},
+	"azurerm_windows_web_app_slot_ftps_state":                                {CIS: []string{"9.10"}, MCSB: []string{"DP-3", "NS-8"}, NIST: []string{"CM-7", "SC-8"}},
+	"azurerm_windows_web_app_slot_https_only":                                {CIS: []string{"9.2"}, MCSB: []string{"DP-3"}, NIST: []string{"SC-8", "SC-8(1)"}},
+	"azurerm_windows_web_app_slot_minimum_tls_version":                       {CIS: []string{"9.3"}, MCSB: []string{"DP-3", "NS-8"}, NIST: []string{"SC-8", "SC-13"}},
+}
+
+// ComplianceControls returns the controls the rule of the given name maps to
+func ComplianceControls(name string) (Controls, bool) {
+	controls, ok := compliance[name]
+	return controls, ok
+}
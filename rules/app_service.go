@@ -0,0 +1,126 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// appServiceResourceTypes are the App Service web and function apps, including their deployment slots
+var appServiceResourceTypes = []string{
+	"azurerm_linux_function_app",
+	"azurerm_linux_function_app_slot",
+	"azurerm_linux_web_app",
+	"azurerm_linux_web_app_slot",
+	"azurerm_windows_function_app",
+	"azurerm_windows_function_app_slot",
+	"azurerm_windows_web_app",
+	"azurerm_windows_web_app_slot",
+}
+
+// appServiceRuleSpecs declares the attribute rules of the App Service web and function apps
+var appServiceRuleSpecs = []*AttributeRuleSpec{
+	{
+		Name:          "https_only",
+		ResourceTypes: appServiceResourceTypes,
+		AttributePath: []string{"https_only"},
+		Type:          cty.Bool,
+		Expected:      []string{"true"},
+		Enabled:       true,
+		Severity:      tflint.WARNING,
+		Messages: AttributeRuleMessages{
+			MissingAttribute: "https_only is not defined and should be true",
+			InvalidValue:     "https_only should be true",
+		},
+	},
+	{
+		Name:          "ftps_state",
+		ResourceTypes: appServiceResourceTypes,
+		AttributePath: []string{"site_config", "ftps_state"},
+		Type:          cty.String,
+		Expected:      []string{"Disabled"},
+		IgnoreCase:    true,
+		Enabled:       true,
+		Severity:      tflint.WARNING,
+		Messages: AttributeRuleMessages{
+			MissingBlock:     "site_config block is missing, ftps_state should be set to {expected}",
+			MissingAttribute: "ftps_state is missing in site_config, should be set to {expected}",
+			InvalidValue:     "ftps_state is set to {value}, should be set to {expected}",
+		},
+		DecodeConfig: decodeFtpsStateRuleConfig,
+	},
+	{
+		Name:          "minimum_tls_version",
+		ResourceTypes: appServiceResourceTypes,
+		AttributePath: []string{"site_config", "minimum_tls_version"},
+		Type:          cty.String,
+		Expected:      tlsVersionsAtLeast(DefaultMinimumTLSVersion, appServiceTLSVersions),
+		Enabled:       true,
+		Severity:      tflint.WARNING,
+		Messages: AttributeRuleMessages{
+			MissingBlock:     "site_config block is missing, minimum_tls_version should be set to {expected}",
+			MissingAttribute: "minimum_tls_version is missing in site_config, should be set to {expected}",
+			InvalidValue:     "minimum_tls_version is set to {value}, should be {expected}",
+		},
+		TLSVersions:  appServiceTLSVersions,
+		DecodeConfig: decodeTLSVersionRuleConfig,
+	},
+	{
+		Name: "scm_ip_restriction_default_action",
+		ResourceTypes: []string{
+			"azurerm_linux_function_app",
+			"azurerm_linux_web_app",
+			"azurerm_windows_function_app",
+			"azurerm_windows_web_app",
+		},
+		AttributePath: []string{"site_config", "scm_ip_restriction_default_action"},
+		Type:          cty.String,
+		Expected:      []string{"Deny"},
+		Enabled:       true,
+		Severity:      tflint.WARNING,
+		Messages: AttributeRuleMessages{
+			MissingBlock:     "scm_ip_restriction_default_action is not defined and should be {expected}",
+			MissingAttribute: "scm_ip_restriction_default_action is not defined and should be {expected}",
+			InvalidValue:     "scm_ip_restriction_default_action should be {expected}",
+		},
+		MissingAttributeAtResource: true,
+		DecodeConfig:               decodeDefaultActionRuleConfig,
+	},
+}
+
+// decodeTLSVersionRuleConfig decodes the allowed_versions of a TLS version rule
+func decodeTLSVersionRuleConfig(runner tflint.Runner, ruleName string, expected []string) ([]string, error) {
+	config := tlsVersionRuleConfig{AllowedVersions: expected}
+	if err := runner.DecodeRuleConfig(ruleName, &config); err != nil {
+		return nil, err
+	}
+	if len(config.AllowedVersions) == 0 {
+		return nil, fmt.Errorf("allowed_versions must not be empty")
+	}
+	return config.AllowedVersions, nil
+}
+
+// decodeFtpsStateRuleConfig decodes the allowed_states of an ftps_state rule
+func decodeFtpsStateRuleConfig(runner tflint.Runner, ruleName string, expected []string) ([]string, error) {
+	config := ftpsStateRuleConfig{AllowedStates: expected}
+	if err := runner.DecodeRuleConfig(ruleName, &config); err != nil {
+		return nil, err
+	}
+	if len(config.AllowedStates) == 0 {
+		return nil, fmt.Errorf("allowed_states must not be empty")
+	}
+	return config.AllowedStates, nil
+}
+
+// decodeDefaultActionRuleConfig decodes the default_action of a network default action rule
+func decodeDefaultActionRuleConfig(runner tflint.Runner, ruleName string, expected []string) ([]string, error) {
+	config := defaultActionRuleConfig{DefaultAction: expected[0]}
+	if err := runner.DecodeRuleConfig(ruleName, &config); err != nil {
+		return nil, err
+	}
+	if config.DefaultAction == "" {
+		return nil, fmt.Errorf("default_action must not be empty")
+	}
+	return []string{config.DefaultAction}, nil
+}
@@ -0,0 +1,343 @@
+package rules
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+)
+
+// attributeRuleSpecs declares the attribute rules, each spec produces one rule per resource type
+var attributeRuleSpecs = slices.Concat(
+	appServiceRuleSpecs,
+	containerRegistryRuleSpecs,
+	cosmosdbAccountRuleSpecs,
+	eventhubNamespaceRuleSpecs,
+	flexibleServerRuleSpecs,
+	iothubRuleSpecs,
+	kubernetesClusterRuleSpecs,
+	mssqlDatabaseRuleSpecs,
+	servicebusNamespaceRuleSpecs,
+	storageAccountRuleSpecs,
+)
+
+// AttributeRuleSpec declares a rule that checks an attribute, optionally nested in blocks, against expected values.
+// A rule named "<resource type>_<Name>" is produced for every resource type of the spec.
+type AttributeRuleSpec struct {
+	// Name is the rule name without the resource type prefix
+	Name          string
+	ResourceTypes []string
+	// AttributePath lists the nested block types followed by the attribute name
+	AttributePath []string
+	// Type is the type of the attribute, either cty.Bool or cty.String
+	Type cty.Type
+	// Expected lists the accepted values, the first one is used by autofix
+	Expected   []string
+	IgnoreCase bool
+	Enabled    bool
+	Severity   tflint.Severity
+	Messages   AttributeRuleMessages
+	// NoBlockFix disables the autofix of missing blocks, for blocks with other required attributes
+	NoBlockFix bool
+	// NoFix disables the autofix, for attributes that force the replacement of the resource when changed
+	NoFix bool
+	// OptionalAttribute skips blocks without the attribute, for attributes whose default is one of the expected values
+	OptionalAttribute bool
+	// MissingAttributeAtResource reports a missing nested attribute at the resource rather than at its block
+	MissingAttributeAtResource bool
+	// Condition restricts the rule to the resources it applies to, such as those of a SKU
+	Condition *AttributeRuleCondition
+	// Unless skips the resources for which one of the conditions holds, such as those not supporting the attribute
+	Unless []*AttributeRuleCondition
+
+	// TLSVersions lists the values of a TLS version attribute from oldest to newest.
+	// When set, the expected values follow the minimum_tls_version setting of the plugin block.
+	TLSVersions []string
+	// DecodeConfig decodes the `rule` block, returning the expected values it overrides
+	DecodeConfig func(runner tflint.Runner, ruleName string, expected []string) ([]string, error)
+}
+
+// AttributeRuleCondition restricts an attribute rule to the resources whose top-level attribute is set to one of the values.
+// Resources without the attribute, or whose attribute cannot be evaluated, are not checked.
+type AttributeRuleCondition struct {
+	Attribute string
+	Values    []string
+}
+
+// Holds returns whether the attribute of the resource is set to one of the values of the condition
+func (c *AttributeRuleCondition) Holds(runner tflint.Runner, resource *hclext.Block) (bool, error) {
+	attribute, exists := resource.Body.Attributes[c.Attribute]
+	if !exists {
+		return false, nil
+	}
+
+	holds := false
+	err := evaluateUnmarked(runner, attribute.Expr, func(val cty.Value) error {
+		if !val.IsWhollyKnown() || val.IsNull() {
+			return nil
+		}
+		val, err := convert.Convert(val, cty.String)
+		if err != nil {
+			return nil
+		}
+		holds = slices.Contains(c.Values, val.AsString())
+		return nil
+	})
+	return holds, err
+}
+
+// AttributeRuleMessages are the issue messages of an attribute rule.
+// The {expected} placeholder is replaced by the accepted values, {value} by the actual value
+// and {attribute} by the attribute name of the azurerm major version in use.
+type AttributeRuleMessages struct {
+	MissingBlock     string
+	MissingAttribute string
+	InvalidValue     string
+}
+
+// AttributeRule checks an attribute of a resource against the values declared in its spec
+type AttributeRule struct {
+	tflint.DefaultRule
+
+	resourceType string
+	spec         *AttributeRuleSpec
+	expected     []string
+}
+
+// NewAttributeRules returns a rule instance for every resource type of every attribute rule spec
+func NewAttributeRules() []tflint.Rule {
+	var rules []tflint.Rule
+	for _, spec := range attributeRuleSpecs {
+		for _, resourceType := range spec.ResourceTypes {
+			rules = append(rules, newAttributeRule(spec, resourceType))
+		}
+	}
+	return rules
+}
+
+func newAttributeRule(spec *AttributeRuleSpec, resourceType string) *AttributeRule {
+	return &AttributeRule{
+		resourceType: resourceType,
+		spec:         spec,
+		expected:     spec.Expected,
+	}
+}
+
+// Name returns the rule name
+func (r *AttributeRule) Name() string {
+	return r.resourceType + "_" + r.spec.Name
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AttributeRule) Enabled() bool {
+	return r.spec.Enabled
+}
+
+// Severity returns the rule severity
+func (r *AttributeRule) Severity() tflint.Severity {
+	return r.spec.Severity
+}
+
+// Link returns the rule reference link
+func (r *AttributeRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// ApplyConfig applies the plugin-wide minimum TLS version to TLS version rules
+func (r *AttributeRule) ApplyConfig(config *Config) {
+	if len(r.spec.TLSVersions) > 0 {
+		r.expected = tlsVersionsAtLeast(config.MinimumTLSVersion, r.spec.TLSVersions)
+	}
+}
+
+// Check verifies that the attribute is set to one of the expected values
+func (r *AttributeRule) Check(runner tflint.Runner) error {
+	expected := r.expected
+	if r.spec.DecodeConfig != nil {
+		var err error
+		if expected, err = r.spec.DecodeConfig(runner, r.Name(), expected); err != nil {
+			return err
+		}
+	}
+
+	blockTypes := r.spec.AttributePath[:len(r.spec.AttributePath)-1]
+	attributeName := r.spec.AttributePath[len(r.spec.AttributePath)-1]
+
+	// Top-level attributes renamed in azurerm v4 are checked under the name of the major version in use
+	var versioned *versionedAttribute
+	if _, renamed := azurermAttributeRenames[r.resourceType][attributeName]; renamed && len(blockTypes) == 0 {
+		var err error
+		if versioned, err = newVersionedAttribute(runner, r.resourceType, attributeName); err != nil {
+			return err
+		}
+		attributeName = versioned.Name
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, r.schema(versioned), nil)
+	if err != nil {
+		return err
+	}
+
+	fixValue := r.value(expected[0])
+
+	for _, resource := range resources.Blocks {
+		applies, err := r.applies(runner, resource)
+		if err != nil {
+			return err
+		}
+		if !applies {
+			continue
+		}
+
+		block := resource
+		for i, blockType := range blockTypes {
+			nestedBlocks := block.Body.Blocks.OfType(blockType)
+			if len(nestedBlocks) == 0 {
+				message := r.message(r.spec.Messages.MissingBlock, expected, "", attributeName)
+				if r.spec.NoBlockFix {
+					err = runner.EmitIssue(r, message, block.DefRange)
+				} else {
+					err = r.emitIssue(runner, message, block.DefRange, fixInsertNestedBlock(runner, block, r.spec.AttributePath[i:], fixValue))
+				}
+				if err != nil {
+					return err
+				}
+				block = nil
+				break
+			}
+			block = nestedBlocks[0]
+		}
+		if block == nil {
+			continue
+		}
+
+		attribute, exists := block.Body.Attributes[attributeName]
+		if versioned != nil {
+			if attribute, exists, err = versioned.Lookup(runner, r, block); err != nil {
+				return err
+			}
+		}
+		if !exists && r.spec.OptionalAttribute {
+			continue
+		}
+		if !exists {
+			issueRange := block.DefRange
+			if r.spec.MissingAttributeAtResource {
+				issueRange = resource.DefRange
+			}
+			if err := r.emitIssue(
+				runner,
+				r.message(r.spec.Messages.MissingAttribute, expected, "", attributeName),
+				issueRange,
+				fixInsertAttribute(runner, block, attributeName, fixValue),
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		err = r.evaluate(runner, attribute, func(val string) error {
+			if r.matches(val, expected) {
+				return nil
+			}
+			return r.emitIssue(
+				runner,
+				r.message(r.spec.Messages.InvalidValue, expected, val, attributeName),
+				attribute.Expr.Range(),
+				fixReplaceLiteral(attribute, fixValue),
+			)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// emitIssue emits the issue with its autofix, unless the autofix is disabled by the spec
+func (r *AttributeRule) emitIssue(runner tflint.Runner, message string, issueRange hcl.Range, fix func(tflint.Fixer) error) error {
+	if r.spec.NoFix {
+		return runner.EmitIssue(r, message, issueRange)
+	}
+	return runner.EmitIssueWithFix(r, message, issueRange, fix)
+}
+
+// schema returns the body schema following the attribute path, with both names of a versioned attribute
+func (r *AttributeRule) schema(versioned *versionedAttribute) *hclext.BodySchema {
+	path := r.spec.AttributePath
+	schema := &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: path[len(path)-1]}},
+	}
+	if versioned != nil {
+		schema.Attributes = versioned.Schema()
+	}
+	for i := len(path) - 2; i >= 0; i-- {
+		schema = &hclext.BodySchema{
+			Blocks: []hclext.BlockSchema{{Type: path[i], Body: schema}},
+		}
+	}
+	if r.spec.Condition != nil {
+		schema.Attributes = append(schema.Attributes, hclext.AttributeSchema{Name: r.spec.Condition.Attribute})
+	}
+	for _, condition := range r.spec.Unless {
+		schema.Attributes = append(schema.Attributes, hclext.AttributeSchema{Name: condition.Attribute})
+	}
+	return schema
+}
+
+// applies returns whether the condition of the spec, if any, holds for the resource, and none of its Unless conditions
+func (r *AttributeRule) applies(runner tflint.Runner, resource *hclext.Block) (bool, error) {
+	for _, condition := range r.spec.Unless {
+		holds, err := condition.Holds(runner, resource)
+		if err != nil || holds {
+			return false, err
+		}
+	}
+	if r.spec.Condition == nil {
+		return true, nil
+	}
+	return r.spec.Condition.Holds(runner, resource)
+}
+
+// evaluate evaluates the attribute according to the spec type and passes its string representation to the callback
+func (r *AttributeRule) evaluate(runner tflint.Runner, attribute *hclext.Attribute, callback func(val string) error) error {
+	if r.spec.Type == cty.Bool {
+		return runner.EvaluateExpr(attribute.Expr, func(val bool) error {
+			return callback(strconv.FormatBool(val))
+		}, nil)
+	}
+	return runner.EvaluateExpr(attribute.Expr, callback, nil)
+}
+
+func (r *AttributeRule) matches(val string, expected []string) bool {
+	for _, value := range expected {
+		if val == value || (r.spec.IgnoreCase && strings.EqualFold(val, value)) {
+			return true
+		}
+	}
+	return false
+}
+
+// value returns the expected value as a cty.Value of the spec type
+func (r *AttributeRule) value(expected string) cty.Value {
+	if r.spec.Type == cty.Bool {
+		return cty.BoolVal(expected == "true")
+	}
+	return cty.StringVal(expected)
+}
+
+func (r *AttributeRule) message(format string, expected []string, val string, attributeName string) string {
+	return strings.NewReplacer(
+		"{expected}", strings.Join(expected, " or "),
+		"{value}", val,
+		"{attribute}", attributeName,
+	).Replace(format)
+}
@@ -0,0 +1,200 @@
+package rules
+
+import (
+	"slices"
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// findRule returns the rule of the given name among the rule instances, as the rule type
+func findRule[T tflint.Rule](t *testing.T, rules []tflint.Rule, name string) T {
+	t.Helper()
+
+	for _, rule := range rules {
+		if rule.Name() == name {
+			return rule.(T)
+		}
+	}
+	t.Fatalf("Rule %s not found", name)
+	var rule T
+	return rule
+}
+
+// findAttributeRule returns the attribute rule of the given name
+func findAttributeRule(t *testing.T, name string) *AttributeRule {
+	t.Helper()
+
+	return findRule[*AttributeRule](t, NewAttributeRules(), name)
+}
+
+func Test_NewAttributeRules(t *testing.T) {
+	names := map[string]bool{}
+	for _, rule := range NewAttributeRules() {
+		if names[rule.Name()] {
+			t.Errorf("Rule %s is declared more than once", rule.Name())
+		}
+		names[rule.Name()] = true
+	}
+
+	for _, spec := range attributeRuleSpecs {
+		if len(spec.AttributePath) == 0 || len(spec.Expected) == 0 {
+			t.Errorf("Spec %s must declare an attribute path and expected values", spec.Name)
+		}
+		if len(spec.AttributePath) > 1 && spec.Messages.MissingBlock == "" {
+			t.Errorf("Spec %s must declare a message for missing blocks", spec.Name)
+		}
+	}
+}
+
+func Test_AttributeRuleApplyConfig(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_linux_web_app_minimum_tls_version")
+
+	config := NewConfig()
+	config.MinimumTLSVersion = "1.3"
+	rule.ApplyConfig(config)
+
+	if !slices.Equal(rule.expected, []string{"1.3"}) {
+		t.Errorf("Expected [1.3], got %v", rule.expected)
+	}
+}
+
+func Test_AttributeRuleNestedBlocks(t *testing.T) {
+	rule := newAttributeRule(&AttributeRuleSpec{
+		Name:          "encryption_enabled",
+		ResourceTypes: []string{"azurerm_example"},
+		AttributePath: []string{"security", "encryption", "enabled"},
+		Type:          cty.Bool,
+		Expected:      []string{"true"},
+		Enabled:       true,
+		Severity:      tflint.WARNING,
+		Messages: AttributeRuleMessages{
+			MissingBlock:     "enabled is not defined and should be {expected}",
+			MissingAttribute: "enabled is missing, should be {expected}",
+			InvalidValue:     "enabled is {value}, should be {expected}",
+		},
+	}, "azurerm_example")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+		Fixed    string
+	}{
+		{
+			Name: "value not expected",
+			Content: `
+resource "azurerm_example" "example" {
+  security {
+    encryption {
+      enabled = false
+    }
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "enabled is false, should be true",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 17},
+						End:      hcl.Pos{Line: 5, Column: 22},
+					},
+				},
+			},
+			Fixed: `
+resource "azurerm_example" "example" {
+  security {
+    encryption {
+      enabled = true
+    }
+  }
+}`,
+		},
+		{
+			Name: "innermost block missing",
+			Content: `
+resource "azurerm_example" "example" {
+  security {
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "enabled is not defined and should be true",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 11},
+					},
+				},
+			},
+			Fixed: `
+resource "azurerm_example" "example" {
+  security {
+    encryption {
+      enabled = true
+    }
+  }
+}`,
+		},
+		{
+			Name: "outermost block missing",
+			Content: `
+resource "azurerm_example" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "enabled is not defined and should be true",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 37},
+					},
+				},
+			},
+			Fixed: `
+resource "azurerm_example" "example" {
+  security {
+    encryption {
+      enabled = true
+    }
+  }
+}`,
+		},
+		{
+			Name: "value expected",
+			Content: `
+resource "azurerm_example" "example" {
+  security {
+    encryption {
+      enabled = true
+    }
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+
+			expected := map[string]string{}
+			if test.Fixed != "" {
+				expected["resource.tf"] = test.Fixed
+			}
+			helper.AssertChanges(t, expected, runner.Changes())
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// newAuthorizationRuleManageSpec returns the spec of the rules reporting shared access policies with Manage rights,
+// for the authorization rule resource types of a messaging service
+func newAuthorizationRuleManageSpec(resourceTypes []string) *AttributeRuleSpec {
+	return &AttributeRuleSpec{
+		Name:          "manage",
+		ResourceTypes: resourceTypes,
+		AttributePath: []string{"manage"},
+		Type:          cty.Bool,
+		Expected:      []string{"false"},
+		Enabled:       true,
+		Severity:      tflint.WARNING,
+		Messages: AttributeRuleMessages{
+			InvalidValue: "manage should be false, Manage rights allow changing the entity and its authorization rules, grant listen or send only",
+		},
+		// manage defaults to false
+		OptionalAttribute: true,
+	}
+}
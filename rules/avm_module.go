@@ -0,0 +1,272 @@
+package rules
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+)
+
+// avmModule maps the inputs of an Azure Verified Module to the azurerm attributes enforced by the other rules
+type avmModule struct {
+	// Source is the registry source of the module, without the registry host
+	Source       string
+	ResourceType string
+	Inputs       []avmInput
+}
+
+// avmInput is a module input checked against the values enforced for the azurerm attribute it is passed to
+type avmInput struct {
+	// Path is the input name, followed by the keys of the attribute within object inputs
+	Path []string
+	// Attribute is the azurerm attribute the input is passed to
+	Attribute string
+	Type      cty.Type
+	Expected  []string
+	// TLSVersions lists the values of a TLS version input from oldest to newest.
+	// When set, the expected values follow the minimum_tls_version setting of the plugin block.
+	TLSVersions []string
+	// Rule is the rule enforcing the attribute when it is disabled by default.
+	// When set, the input is only checked while that rule is enabled.
+	Rule string
+}
+
+// avmDottedTLSVersions are the TLS versions accepted by modules that take them as "1.x"
+var avmDottedTLSVersions = []string{"1.0", "1.1", "1.2"}
+
+// avmModules are the Azure Verified Modules whose inputs are checked
+var avmModules = []*avmModule{
+	{
+		Source:       "Azure/avm-res-storage-storageaccount/azurerm",
+		ResourceType: "azurerm_storage_account",
+		Inputs: []avmInput{
+			{Path: []string{"public_network_access_enabled"}, Attribute: "public_network_access_enabled", Type: cty.Bool, Expected: []string{"false"}},
+			{Path: []string{"https_traffic_only_enabled"}, Attribute: "https_traffic_only_enabled", Type: cty.Bool, Expected: []string{"true"}},
+			{Path: []string{"cross_tenant_replication_enabled"}, Attribute: "cross_tenant_replication_enabled", Type: cty.Bool, Expected: []string{"false"}},
+			{Path: []string{"default_to_oauth_authentication"}, Attribute: "default_to_oauth_authentication", Type: cty.Bool, Expected: []string{"true"}},
+			{Path: []string{"min_tls_version"}, Attribute: "min_tls_version", Type: cty.String, TLSVersions: storageTLSVersions},
+			{Path: []string{"network_rules", "default_action"}, Attribute: "network_rules.default_action", Type: cty.String, Expected: []string{"Deny"}},
+		},
+	},
+	{
+		Source:       "Azure/avm-res-keyvault-vault/azurerm",
+		ResourceType: "azurerm_key_vault",
+		Inputs: []avmInput{
+			{Path: []string{"public_network_access_enabled"}, Attribute: "public_network_access_enabled", Type: cty.Bool, Expected: []string{"false"}},
+			{Path: []string{"legacy_access_policies_enabled"}, Attribute: "enable_rbac_authorization", Type: cty.Bool, Expected: []string{"false"}, Rule: "azurerm_key_vault_enable_rbac_authorization"},
+			{Path: []string{"network_acls", "default_action"}, Attribute: "network_acls.default_action", Type: cty.String, Expected: []string{"Deny"}},
+		},
+	},
+	{
+		Source:       "Azure/avm-res-eventhub-namespace/azurerm",
+		ResourceType: "azurerm_eventhub_namespace",
+		Inputs: []avmInput{
+			{Path: []string{"public_network_access_enabled"}, Attribute: "public_network_access_enabled", Type: cty.Bool, Expected: []string{"false"}},
+			{Path: []string{"minimum_tls_version"}, Attribute: "minimum_tls_version", Type: cty.String, TLSVersions: avmDottedTLSVersions},
+		},
+	},
+	{
+		Source:       "Azure/avm-res-cache-redis/azurerm",
+		ResourceType: "azurerm_redis_cache",
+		Inputs: []avmInput{
+			{Path: []string{"public_network_access_enabled"}, Attribute: "public_network_access_enabled", Type: cty.Bool, Expected: []string{"false"}},
+			{Path: []string{"non_ssl_port_enabled"}, Attribute: "non_ssl_port_enabled", Type: cty.Bool, Expected: []string{"false"}},
+			{Path: []string{"minimum_tls_version"}, Attribute: "minimum_tls_version", Type: cty.String, TLSVersions: redisTLSVersions},
+		},
+	},
+	{
+		Source:       "Azure/avm-res-sql-server/azurerm",
+		ResourceType: "azurerm_mssql_server",
+		Inputs: []avmInput{
+			{Path: []string{"public_network_access_enabled"}, Attribute: "public_network_access_enabled", Type: cty.Bool, Expected: []string{"false"}},
+		},
+	},
+}
+
+// AVMModuleInputs checks the inputs of the module calls of an Azure Verified Module.
+// Inputs are read from the module block, so the module source does not need to be downloaded.
+type AVMModuleInputs struct {
+	tflint.DefaultRule
+
+	resourceType string
+	module       *avmModule
+	tlsVersion   string
+	enabledRules map[string]bool
+}
+
+// NewAVMModuleInputsRules returns a rule instance for every Azure Verified Module
+func NewAVMModuleInputsRules() []tflint.Rule {
+	var rules []tflint.Rule
+	for _, module := range avmModules {
+		rules = append(rules, &AVMModuleInputs{
+			resourceType: module.ResourceType,
+			module:       module,
+			tlsVersion:   DefaultMinimumTLSVersion,
+		})
+	}
+	return rules
+}
+
+// Name returns the rule name
+func (r *AVMModuleInputs) Name() string {
+	return r.resourceType + "_avm_module_inputs"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AVMModuleInputs) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AVMModuleInputs) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AVMModuleInputs) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// ApplyConfig applies the plugin-wide minimum TLS version to TLS version inputs
+func (r *AVMModuleInputs) ApplyConfig(config *Config) {
+	r.tlsVersion = config.MinimumTLSVersion
+}
+
+// ApplyEnabledRules records the enabled rules, so that inputs enforced by a rule disabled by default
+// are only checked while that rule is enabled
+func (r *AVMModuleInputs) ApplyEnabledRules(enabled []tflint.Rule) {
+	r.enabledRules = map[string]bool{}
+	for _, rule := range enabled {
+		r.enabledRules[rule.Name()] = true
+	}
+}
+
+// Check verifies that the inputs of the module calls are set to the values enforced for the azurerm attributes
+func (r *AVMModuleInputs) Check(runner tflint.Runner) error {
+	attributes := []hclext.AttributeSchema{{Name: "source"}}
+	for _, input := range r.module.Inputs {
+		attributes = append(attributes, hclext.AttributeSchema{Name: input.Path[0]})
+	}
+
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "module",
+				LabelNames: []string{"name"},
+				Body:       &hclext.BodySchema{Attributes: attributes},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, module := range content.Blocks {
+		source, exists := module.Body.Attributes["source"]
+		if !exists {
+			continue
+		}
+		sourceValue, diags := source.Expr.Value(nil)
+		if diags.HasErrors() || sourceValue.Type() != cty.String || !r.matchesSource(sourceValue.AsString()) {
+			continue
+		}
+
+		for _, input := range r.module.Inputs {
+			if input.Rule != "" && !r.enabledRules[input.Rule] {
+				continue
+			}
+			attribute, exists := module.Body.Attributes[input.Path[0]]
+			if !exists {
+				continue
+			}
+			if err := r.checkInput(runner, module.Labels[0], input, attribute.Expr); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// matchesSource returns whether the module source is the module of the rule, with or without the registry host
+func (r *AVMModuleInputs) matchesSource(source string) bool {
+	source = strings.TrimPrefix(source, "registry.terraform.io/")
+	if index := strings.Index(source, "//"); index >= 0 {
+		source = source[:index]
+	}
+	return strings.EqualFold(source, r.module.Source)
+}
+
+// checkInput evaluates the input, following the keys of object inputs, and reports values that are not expected
+func (r *AVMModuleInputs) checkInput(runner tflint.Runner, moduleName string, input avmInput, expr hcl.Expression) error {
+	expected := input.Expected
+	if len(input.TLSVersions) > 0 {
+		expected = tlsVersionsAtLeast(r.tlsVersion, input.TLSVersions)
+	}
+
+	// Follow object constructors so that the issue is reported at the nested argument
+	keys := input.Path[1:]
+	for len(keys) > 0 {
+		object, ok := expr.(*hclsyntax.ObjectConsExpr)
+		if !ok {
+			break
+		}
+		item := objectConsItem(object, keys[0])
+		if item == nil {
+			return nil
+		}
+		expr = item
+		keys = keys[1:]
+	}
+
+	return evaluateUnmarked(runner, expr, func(val cty.Value) error {
+		for _, key := range keys {
+			if val.IsNull() || !val.IsKnown() || !val.Type().IsObjectType() || !val.Type().HasAttribute(key) {
+				return nil
+			}
+			val = val.GetAttr(key)
+		}
+		if val.IsNull() || !val.IsKnown() || !val.Type().Equals(input.Type) {
+			return nil
+		}
+
+		var actual string
+		if input.Type == cty.Bool {
+			actual = strconv.FormatBool(val.True())
+		} else {
+			actual = val.AsString()
+		}
+		for _, value := range expected {
+			if strings.EqualFold(actual, value) {
+				return nil
+			}
+		}
+
+		return runner.EmitIssue(
+			r,
+			fmt.Sprintf("module %q input %s is set to %s, should be %s (%s.%s)", moduleName, strings.Join(input.Path, "."), actual, strings.Join(expected, " or "), r.resourceType, input.Attribute),
+			expr.Range(),
+		)
+	})
+}
+
+// objectConsItem returns the value of the item of the object constructor with the given key
+func objectConsItem(object *hclsyntax.ObjectConsExpr, key string) hcl.Expression {
+	for _, item := range object.Items {
+		keyValue, diags := item.KeyExpr.Value(nil)
+		if diags.HasErrors() || keyValue.Type() != cty.String {
+			continue
+		}
+		if keyValue.AsString() == key {
+			return item.ValueExpr
+		}
+	}
+	return nil
+}
@@ -0,0 +1,275 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+func Test_AVMModuleInputs(t *testing.T) {
+	rule := findRule[*AVMModuleInputs](t, NewAVMModuleInputsRules(), "azurerm_storage_account_avm_module_inputs")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "insecure inputs",
+			Content: `
+module "storage" {
+  source  = "Azure/avm-res-storage-storageaccount/azurerm"
+  version = "0.6.0"
+
+  public_network_access_enabled = true
+  min_tls_version               = "TLS1_0"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: `module "storage" input public_network_access_enabled is set to true, should be false (azurerm_storage_account.public_network_access_enabled)`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 35},
+						End:      hcl.Pos{Line: 6, Column: 39},
+					},
+				},
+				{
+					Rule:    rule,
+					Message: `module "storage" input min_tls_version is set to TLS1_0, should be TLS1_2 or TLS1_3 (azurerm_storage_account.min_tls_version)`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 35},
+						End:      hcl.Pos{Line: 7, Column: 43},
+					},
+				},
+			},
+		},
+		{
+			Name: "insecure nested input reported at the object argument",
+			Content: `
+module "storage" {
+  source = "registry.terraform.io/Azure/avm-res-storage-storageaccount/azurerm"
+
+  network_rules = {
+    bypass         = ["AzureServices"]
+    default_action = "Allow"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: `module "storage" input network_rules.default_action is set to Allow, should be Deny (azurerm_storage_account.network_rules.default_action)`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 22},
+						End:      hcl.Pos{Line: 7, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			Name: "insecure nested input passed through a variable",
+			Content: `
+variable "network_rules" {
+  default = {
+    default_action = "Allow"
+  }
+}
+
+module "storage" {
+  source        = "Azure/avm-res-storage-storageaccount/azurerm"
+  network_rules = var.network_rules
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: `module "storage" input network_rules.default_action is set to Allow, should be Deny (azurerm_storage_account.network_rules.default_action)`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 10, Column: 19},
+						End:      hcl.Pos{Line: 10, Column: 36},
+					},
+				},
+			},
+		},
+		{
+			Name: "insecure inputs passed through sensitive variables",
+			Content: `
+variable "public" {
+  default   = true
+  sensitive = true
+}
+
+variable "network_rules" {
+  default = {
+    default_action = "Allow"
+  }
+  sensitive = true
+}
+
+module "storage" {
+  source                        = "Azure/avm-res-storage-storageaccount/azurerm"
+  public_network_access_enabled = var.public
+  network_rules                 = var.network_rules
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: `module "storage" input public_network_access_enabled is set to true, should be false (azurerm_storage_account.public_network_access_enabled)`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 16, Column: 35},
+						End:      hcl.Pos{Line: 16, Column: 45},
+					},
+				},
+				{
+					Rule:    rule,
+					Message: `module "storage" input network_rules.default_action is set to Allow, should be Deny (azurerm_storage_account.network_rules.default_action)`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 17, Column: 35},
+						End:      hcl.Pos{Line: 17, Column: 52},
+					},
+				},
+			},
+		},
+		{
+			Name: "secure inputs",
+			Content: `
+module "storage" {
+  source = "Azure/avm-res-storage-storageaccount/azurerm"
+
+  public_network_access_enabled = false
+  min_tls_version               = "TLS1_2"
+
+  network_rules = {
+    default_action = "Deny"
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "inputs left to module defaults",
+			Content: `
+module "storage" {
+  source = "Azure/avm-res-storage-storageaccount/azurerm"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "unknown input",
+			Content: `
+variable "public" {}
+
+module "storage" {
+  source                        = "Azure/avm-res-storage-storageaccount/azurerm"
+  public_network_access_enabled = var.public
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "other module",
+			Content: `
+module "vault" {
+  source                        = "Azure/avm-res-keyvault-vault/azurerm"
+  min_tls_version               = "TLS1_0"
+  public_network_access_enabled = false
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
+
+func Test_AVMModuleInputsApplyConfig(t *testing.T) {
+	rule := findRule[*AVMModuleInputs](t, NewAVMModuleInputsRules(), "azurerm_eventhub_namespace_avm_module_inputs")
+
+	config := NewConfig()
+	config.MinimumTLSVersion = "1.3"
+	rule.ApplyConfig(config)
+
+	runner := helper.TestRunner(t, map[string]string{"resource.tf": `
+module "eventhub" {
+  source              = "Azure/avm-res-eventhub-namespace/azurerm"
+  minimum_tls_version = "1.1"
+}`})
+
+	if err := rule.Check(runner); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	helper.AssertIssues(t, helper.Issues{
+		{
+			Rule:    rule,
+			Message: `module "eventhub" input minimum_tls_version is set to 1.1, should be 1.2 (azurerm_eventhub_namespace.minimum_tls_version)`,
+			Range: hcl.Range{
+				Filename: "resource.tf",
+				Start:    hcl.Pos{Line: 4, Column: 25},
+				End:      hcl.Pos{Line: 4, Column: 30},
+			},
+		},
+	}, runner.Issues)
+}
+
+func Test_AVMModuleInputsApplyEnabledRules(t *testing.T) {
+	rule := findRule[*AVMModuleInputs](t, NewAVMModuleInputsRules(), "azurerm_key_vault_avm_module_inputs")
+	content := `
+module "vault" {
+  source                         = "Azure/avm-res-keyvault-vault/azurerm"
+  legacy_access_policies_enabled = true
+}`
+
+	tests := []struct {
+		Name     string
+		Enabled  []tflint.Rule
+		Expected helper.Issues
+	}{
+		{
+			Name:     "input skipped while the rule of the attribute is disabled",
+			Enabled:  []tflint.Rule{},
+			Expected: helper.Issues{},
+		},
+		{
+			Name:    "input checked once the rule of the attribute is enabled",
+			Enabled: []tflint.Rule{NewAzurermKeyVaultRbacDisabled()},
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: `module "vault" input legacy_access_policies_enabled is set to true, should be false (azurerm_key_vault.enable_rbac_authorization)`,
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 36},
+						End:      hcl.Pos{Line: 4, Column: 40},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			rule.ApplyEnabledRules(test.Enabled)
+
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -57,6 +57,8 @@ func (r *AzurermContainerGroupImageRegistryCredentialIdentity) Check(runner tfli
 					Attributes: []hclext.AttributeSchema{
 						{Name: "user_assigned_identity_id"},
 						{Name: "server"},
+						{Name: "username"},
+						{Name: "password"},
 					},
 				},
 			},
@@ -76,46 +78,43 @@ func (r *AzurermContainerGroupImageRegistryCredentialIdentity) Check(runner tfli
 
 			val, diags := server.Expr.Value(nil)
 			isAzureCR := false
+			forServer := ""
 
 			if diags.HasErrors() || val.IsNull() {
 				// If we can't evaluate the server value directly (e.g., it's a reference),
 				// check if it's referencing an Azure Container Registry
-				serverExpr := server.Expr.Variables()
-				for _, v := range serverExpr {
-					if len(v) > 0 {
-						if root, ok := v[0].(hcl.TraverseRoot); ok {
-							if strings.Contains(root.Name, "azurerm_container_registry") {
-								isAzureCR = true
-								break
-							}
-						}
-					}
-				}
+				isAzureCR = referencesContainerRegistry(server.Expr)
 			} else {
 				// For literal string values
 				serverStr := val.AsString()
 				isAzureCR = strings.HasSuffix(serverStr, ".azurecr.io")
-				if isAzureCR {
-					if _, exists := cred.Body.Attributes["user_assigned_identity_id"]; !exists {
-						runner.EmitIssue(
-							r,
-							fmt.Sprintf("user_assigned_identity_id is missing in image_registry_credential for Azure Container Registry image for server %s", serverStr),
-							cred.DefRange,
-						)
-						continue
-					}
+				forServer = fmt.Sprintf(" for server %s", serverStr)
+			}
+			if !isAzureCR {
+				continue
+			}
+
+			// Admin or token credentials are reported even with an identity, they should not be kept around
+			_, hasUsername := cred.Body.Attributes["username"]
+			_, hasPassword := cred.Body.Attributes["password"]
+			if hasUsername || hasPassword {
+				if err := runner.EmitIssue(
+					r,
+					fmt.Sprintf("image_registry_credential uses username and password for Azure Container Registry image%s, use user_assigned_identity_id instead", forServer),
+					cred.DefRange,
+				); err != nil {
+					return err
 				}
 				continue
 			}
 
-			// For references (not literal values)
-			if isAzureCR {
-				if _, exists := cred.Body.Attributes["user_assigned_identity_id"]; !exists {
-					runner.EmitIssue(
-						r,
-						"user_assigned_identity_id is missing in image_registry_credential for Azure Container Registry image",
-						cred.DefRange,
-					)
+			if _, exists := cred.Body.Attributes["user_assigned_identity_id"]; !exists {
+				if err := runner.EmitIssue(
+					r,
+					fmt.Sprintf("user_assigned_identity_id is missing in image_registry_credential for Azure Container Registry image%s", forServer),
+					cred.DefRange,
+				); err != nil {
+					return err
 				}
 			}
 		}
@@ -123,3 +122,20 @@ func (r *AzurermContainerGroupImageRegistryCredentialIdentity) Check(runner tfli
 
 	return nil
 }
+
+// referencesContainerRegistry returns whether the expression references an azurerm_container_registry
+// resource or data source, such as its login_server
+func referencesContainerRegistry(expr hcl.Expression) bool {
+	for _, traversal := range expr.Variables() {
+		if strings.Contains(traversal.RootName(), "azurerm_container_registry") {
+			return true
+		}
+		if traversal.RootName() != "data" || len(traversal) < 2 {
+			continue
+		}
+		if attr, ok := traversal[1].(hcl.TraverseAttr); ok && attr.Name == "azurerm_container_registry" {
+			return true
+		}
+	}
+	return false
+}
@@ -75,6 +75,67 @@ resource "azurerm_container_group" "example" {
     server = azurerm_container_registry.example.login_server
     user_assigned_identity_id = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/test/providers/Microsoft.ManagedIdentity/userAssignedIdentities/test"
   }
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "username and password",
+			content: `
+resource "azurerm_container_group" "example" {
+  image_registry_credential {
+    server   = "example.azurecr.io"
+    username = "example"
+    password = "secret"
+  }
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "image_registry_credential uses username and password for Azure Container Registry image for server example.azurecr.io, use user_assigned_identity_id instead",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 28},
+					},
+				},
+			},
+		},
+		{
+			name: "username and password with admin credentials of the registry",
+			content: `
+data "azurerm_container_registry" "example" {
+  name                = "example"
+  resource_group_name = "example"
+}
+
+resource "azurerm_container_group" "example" {
+  image_registry_credential {
+    server   = data.azurerm_container_registry.example.login_server
+    username = data.azurerm_container_registry.example.admin_username
+    password = data.azurerm_container_registry.example.admin_password
+  }
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "image_registry_credential uses username and password for Azure Container Registry image, use user_assigned_identity_id instead",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 8, Column: 3},
+						End:      hcl.Pos{Line: 8, Column: 28},
+					},
+				},
+			},
+		},
+		{
+			name: "username and password for another registry",
+			content: `
+resource "azurerm_container_group" "example" {
+  image_registry_credential {
+    server   = "index.docker.io"
+    username = "example"
+    password = "secret"
+  }
 }`,
 			expected: helper.Issues{},
 		},
@@ -0,0 +1,49 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermContainerRegistryAdminEnabled(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_container_registry_admin_enabled")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "admin account enabled",
+			Content: `
+resource "azurerm_container_registry" "example" {
+  admin_enabled = true
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "admin_enabled should be false, the admin account shares a single set of credentials with full access",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 19},
+						End:      hcl.Pos{Line: 3, Column: 23},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
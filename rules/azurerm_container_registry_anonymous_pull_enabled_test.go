@@ -0,0 +1,38 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermContainerRegistryAnonymousPullEnabled(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_container_registry_anonymous_pull_enabled")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "anonymous pull disabled",
+			Content: `
+resource "azurerm_container_registry" "example" {
+  anonymous_pull_enabled = false
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
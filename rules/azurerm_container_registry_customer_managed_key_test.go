@@ -0,0 +1,57 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermContainerRegistryCustomerManagedKey(t *testing.T) {
+	rule := findRule[*CustomerManagedKey](t, NewCustomerManagedKeyRules(), "azurerm_container_registry_customer_managed_key")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Premium Container Registry without encryption",
+			Content: `
+resource "azurerm_container_registry" "example" {
+  sku = "Premium"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "encryption block is missing, the Container Registry should be encrypted with a customer-managed key",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 48},
+					},
+				},
+			},
+		},
+		{
+			Name: "Basic Container Registry",
+			Content: `
+resource "azurerm_container_registry" "example" {
+  sku = "Basic"
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+)
+
+// AzurermContainerRegistryDataEndpointEnabled checks that Premium Container Registries reached through
+// private endpoints serve their data through dedicated data endpoints
+type AzurermContainerRegistryDataEndpointEnabled struct {
+	tflint.DefaultRule
+
+	resourceType  string
+	attributeName string
+}
+
+// NewAzurermContainerRegistryDataEndpointEnabled returns a new rule instance
+func NewAzurermContainerRegistryDataEndpointEnabled() *AzurermContainerRegistryDataEndpointEnabled {
+	return &AzurermContainerRegistryDataEndpointEnabled{
+		resourceType:  "azurerm_container_registry",
+		attributeName: "data_endpoint_enabled",
+	}
+}
+
+// Name returns the rule name
+func (r *AzurermContainerRegistryDataEndpointEnabled) Name() string {
+	return "azurerm_container_registry_data_endpoint_enabled"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AzurermContainerRegistryDataEndpointEnabled) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AzurermContainerRegistryDataEndpointEnabled) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *AzurermContainerRegistryDataEndpointEnabled) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks that data_endpoint_enabled is true on Premium registries that are the target of an azurerm_private_endpoint
+func (r *AzurermContainerRegistryDataEndpointEnabled) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: premiumContainerRegistry.Attribute},
+			{Name: r.attributeName},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	connections, err := newAssociationIndex(runner, privateEndpointConnection, r.resourceType)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		if len(resource.Labels) < 2 || len(connections[resource.Labels[1]]) == 0 {
+			continue
+		}
+		premium, err := premiumContainerRegistry.Holds(runner, resource)
+		if err != nil {
+			return err
+		}
+		if !premium {
+			continue
+		}
+
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			if err := runner.EmitIssueWithFix(
+				r,
+				fmt.Sprintf("%s is not defined and should be true for Premium registries with private endpoints", r.attributeName),
+				resource.DefRange,
+				fixInsertAttribute(runner, resource, r.attributeName, cty.True),
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := runner.EvaluateExpr(attribute.Expr, func(val bool) error {
+			if val {
+				return nil
+			}
+			return runner.EmitIssueWithFix(
+				r,
+				fmt.Sprintf("%s should be true for Premium registries with private endpoints", r.attributeName),
+				attribute.Expr.Range(),
+				fixReplaceLiteral(attribute, cty.True),
+			)
+		}, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
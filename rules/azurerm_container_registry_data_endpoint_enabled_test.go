@@ -0,0 +1,94 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermContainerRegistryDataEndpointEnabled(t *testing.T) {
+	rule := NewAzurermContainerRegistryDataEndpointEnabled()
+
+	const privateEndpoint = `
+
+resource "azurerm_private_endpoint" "example" {
+  private_service_connection {
+    private_connection_resource_id = azurerm_container_registry.example.id
+    subresource_names              = ["registry"]
+  }
+}`
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Premium registry with private endpoint and no data endpoint",
+			Content: `
+resource "azurerm_container_registry" "example" {
+  sku = "Premium"
+}` + privateEndpoint,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "data_endpoint_enabled is not defined and should be true for Premium registries with private endpoints",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 48},
+					},
+				},
+			},
+		},
+		{
+			Name: "Premium registry with private endpoint and data endpoint disabled",
+			Content: `
+resource "azurerm_container_registry" "example" {
+  sku                   = "Premium"
+  data_endpoint_enabled = false
+}` + privateEndpoint,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "data_endpoint_enabled should be true for Premium registries with private endpoints",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 27},
+						End:      hcl.Pos{Line: 4, Column: 32},
+					},
+				},
+			},
+		},
+		{
+			Name: "Premium registry with private endpoint and data endpoint",
+			Content: `
+resource "azurerm_container_registry" "example" {
+  sku                   = "Premium"
+  data_endpoint_enabled = true
+}` + privateEndpoint,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Premium registry without private endpoint",
+			Content: `
+resource "azurerm_container_registry" "example" {
+  sku = "Premium"
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermContainerRegistryExportPolicyEnabled(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_container_registry_export_policy_enabled")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "export policy of a private registry missing",
+			Content: `
+resource "azurerm_container_registry" "example" {
+  public_network_access_enabled = false
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "export_policy_enabled is not defined and defaults to true, should be false for registries without public network access",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 48},
+					},
+				},
+			},
+		},
+		{
+			Name: "export policy of a public registry",
+			Content: `
+resource "azurerm_container_registry" "example" {
+  public_network_access_enabled = true
+  export_policy_enabled         = true
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
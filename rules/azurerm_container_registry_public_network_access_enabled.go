@@ -0,0 +1,115 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+)
+
+// AzurermContainerRegistryPublicNetworkAccessEnabled checks that Container Registries are not reachable from all networks
+type AzurermContainerRegistryPublicNetworkAccessEnabled struct {
+	tflint.DefaultRule
+
+	resourceType  string
+	attributeName string
+}
+
+// NewAzurermContainerRegistryPublicNetworkAccessEnabled returns a new rule instance
+func NewAzurermContainerRegistryPublicNetworkAccessEnabled() *AzurermContainerRegistryPublicNetworkAccessEnabled {
+	return &AzurermContainerRegistryPublicNetworkAccessEnabled{
+		resourceType:  "azurerm_container_registry",
+		attributeName: "public_network_access_enabled",
+	}
+}
+
+// Name returns the rule name
+func (r *AzurermContainerRegistryPublicNetworkAccessEnabled) Name() string {
+	return "azurerm_container_registry_public_network_access_enabled"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AzurermContainerRegistryPublicNetworkAccessEnabled) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AzurermContainerRegistryPublicNetworkAccessEnabled) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AzurermContainerRegistryPublicNetworkAccessEnabled) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks that public network access is disabled or that network_rule_set denies access by default
+func (r *AzurermContainerRegistryPublicNetworkAccessEnabled) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.attributeName},
+		},
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "network_rule_set",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: "default_action"},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		denied := false
+		for _, block := range resource.Body.Blocks.OfType("network_rule_set") {
+			attribute, exists := block.Body.Attributes["default_action"]
+			if !exists {
+				continue
+			}
+			if err := runner.EvaluateExpr(attribute.Expr, func(defaultAction string) error {
+				denied = denied || defaultAction == "Deny"
+				return nil
+			}, nil); err != nil {
+				return err
+			}
+		}
+		if denied {
+			continue
+		}
+
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			if err := runner.EmitIssueWithFix(
+				r,
+				"public_network_access_enabled is not defined and defaults to true, consider disabling it or adding network_rule_set with default_action = \"Deny\"",
+				resource.DefRange,
+				fixInsertAttribute(runner, resource, r.attributeName, cty.False),
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := runner.EvaluateExpr(attribute.Expr, func(val bool) error {
+			if !val {
+				return nil
+			}
+			return runner.EmitIssueWithFix(
+				r,
+				"Consider changing public_network_access_enabled to false or add network_rule_set with default_action = \"Deny\"",
+				attribute.Expr.Range(),
+				fixReplaceLiteral(attribute, cty.False),
+			)
+		}, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermContainerRegistryQuarantinePolicyEnabled(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_container_registry_quarantine_policy_enabled")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "quarantine policy of a Premium registry disabled",
+			Content: `
+resource "azurerm_container_registry" "example" {
+  sku                       = "Premium"
+  quarantine_policy_enabled = false
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "quarantine_policy_enabled should be true for Premium registries",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 31},
+						End:      hcl.Pos{Line: 4, Column: 36},
+					},
+				},
+			},
+		},
+		{
+			Name: "quarantine policy of a Standard registry",
+			Content: `
+resource "azurerm_container_registry" "example" {
+  sku = "Standard"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "quarantine policy of a registry with a sensitive SKU",
+			Content: `
+resource "azurerm_container_registry" "example" {
+  sku                       = var.sku
+  quarantine_policy_enabled = false
+}
+
+variable "sku" {
+  default   = "Premium"
+  sensitive = true
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "quarantine_policy_enabled should be true for Premium registries",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 31},
+						End:      hcl.Pos{Line: 4, Column: 36},
+					},
+				},
+			},
+		},
+		{
+			Name: "quarantine policy of a registry with an unknown SKU",
+			Content: `
+variable "sku" {
+  type = string
+}
+
+resource "azurerm_container_registry" "example" {
+  sku = var.sku
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermContainerRegistryRetentionPolicy(t *testing.T) {
+	rule := findRule[*ContainerRegistryPolicy](t, NewContainerRegistryPolicyRules(), "azurerm_container_registry_retention_policy")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "retention policy of zero days",
+			Content: `
+resource "azurerm_container_registry" "example" {
+  sku                      = "Premium"
+  retention_policy_in_days = 0
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "retention_policy_in_days does not enable the retention of untagged manifests, which should be enabled on Premium registries",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 30},
+						End:      hcl.Pos{Line: 4, Column: 31},
+					},
+				},
+			},
+		},
+		{
+			Name: "retention policy of a sensitive number of days",
+			Content: `
+resource "azurerm_container_registry" "example" {
+  sku                      = "Premium"
+  retention_policy_in_days = var.retention_days
+}
+
+variable "retention_days" {
+  default   = 0
+  sensitive = true
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "retention_policy_in_days does not enable the retention of untagged manifests, which should be enabled on Premium registries",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 30},
+						End:      hcl.Pos{Line: 4, Column: 48},
+					},
+				},
+			},
+		},
+		{
+			Name: "retention policy block missing with azurerm v3",
+			Content: azurermV3RequiredProviders + `
+resource "azurerm_container_registry" "example" {
+  sku = "Premium"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "retention_policy block is missing, the retention of untagged manifests should be enabled on Premium registries",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 11, Column: 1},
+						End:      hcl.Pos{Line: 11, Column: 48},
+					},
+				},
+			},
+		},
+		{
+			Name: "retention policy block enabled with azurerm v3",
+			Content: azurermV3RequiredProviders + `
+resource "azurerm_container_registry" "example" {
+  sku = "Premium"
+
+  retention_policy {
+    enabled = true
+    days    = 7
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+const azurermV3RequiredProviders = `
+terraform {
+  required_providers {
+    azurerm = {
+      source  = "hashicorp/azurerm"
+      version = "~> 3.0"
+    }
+  }
+}
+`
+
+func Test_AzurermContainerRegistryTrustPolicy(t *testing.T) {
+	rule := findRule[*ContainerRegistryPolicy](t, NewContainerRegistryPolicyRules(), "azurerm_container_registry_trust_policy")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "trust policy missing",
+			Content: `
+resource "azurerm_container_registry" "example" {
+  sku = "Premium"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "trust_policy_enabled is not defined, content trust should be enabled on Premium registries",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 48},
+					},
+				},
+			},
+		},
+		{
+			Name: "trust policy enabled",
+			Content: `
+resource "azurerm_container_registry" "example" {
+  sku                  = "Premium"
+  trust_policy_enabled = true
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "trust policy of a Basic registry",
+			Content: `
+resource "azurerm_container_registry" "example" {
+  sku = "Basic"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "trust policy block disabled with azurerm v3",
+			Content: azurermV3RequiredProviders + `
+resource "azurerm_container_registry" "example" {
+  sku = "Premium"
+
+  trust_policy {
+    enabled = false
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "enabled should be true in trust_policy, content trust should be enabled on Premium registries",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 15, Column: 15},
+						End:      hcl.Pos{Line: 15, Column: 20},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermCosmosdbAccountAccessKeyMetadataWritesEnabled(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_cosmosdb_account_access_key_metadata_writes_enabled")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "access key metadata writes missing",
+			Content: `
+resource "azurerm_cosmosdb_account" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "access_key_metadata_writes_enabled is not defined and defaults to true, should be false",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 46},
+					},
+				},
+			},
+		},
+		{
+			Name: "access key metadata writes disabled",
+			Content: `
+resource "azurerm_cosmosdb_account" "example" {
+  access_key_metadata_writes_enabled = false
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermCosmosdbAccountBackupType(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_cosmosdb_account_backup_type")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "backup block missing",
+			Content: `
+resource "azurerm_cosmosdb_account" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "backup block is missing and defaults to periodic backups, type should be set to Continuous",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 46},
+					},
+				},
+			},
+		},
+		{
+			Name: "periodic backup",
+			Content: `
+resource "azurerm_cosmosdb_account" "example" {
+  backup {
+    type = "Periodic"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "backup type is set to Periodic, should be set to Continuous for point-in-time restore",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 12},
+						End:      hcl.Pos{Line: 4, Column: 22},
+					},
+				},
+			},
+		},
+		{
+			Name: "continuous backup",
+			Content: `
+resource "azurerm_cosmosdb_account" "example" {
+  backup {
+    type = "Continuous"
+    tier = "Continuous7Days"
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermCosmosdbAccountCustomerManagedKey(t *testing.T) {
+	rule := findRule[*CustomerManagedKey](t, NewCustomerManagedKeyRules(), "azurerm_cosmosdb_account_customer_managed_key")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Cosmos DB account without key",
+			Content: `
+resource "azurerm_cosmosdb_account" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "key_vault_key_id is not defined, the Cosmos DB Account should be encrypted with a customer-managed key",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 46},
+					},
+				},
+			},
+		},
+		{
+			Name: "Cosmos DB account with key",
+			Content: `
+resource "azurerm_cosmosdb_account" "example" {
+  key_vault_key_id = azurerm_key_vault_key.example.versionless_id
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Cosmos DB account with a key rotated through a local value",
+			Content: `
+locals {
+  key_id = azurerm_key_vault_key.example.versionless_id
+}
+
+resource "azurerm_cosmosdb_account" "example" {
+  key_vault_key_id = local.key_id
+}
+
+resource "azurerm_key_vault_key" "example" {
+  rotation_policy {
+    expire_after = "P90D"
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermCosmosdbAccountLocalAuthenticationDisabled(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_cosmosdb_account_local_authentication_disabled")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "local authentication enabled",
+			Content: `
+resource "azurerm_cosmosdb_account" "example" {
+  local_authentication_disabled = false
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "local_authentication_disabled should be true",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 35},
+						End:      hcl.Pos{Line: 3, Column: 40},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermCosmosdbAccountMinimalTLSVersion(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_cosmosdb_account_minimal_tls_version")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "TLS 1.1",
+			Content: `
+resource "azurerm_cosmosdb_account" "example" {
+  minimal_tls_version = "Tls11"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "minimal_tls_version is set to Tls11, should be Tls12",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 25},
+						End:      hcl.Pos{Line: 3, Column: 32},
+					},
+				},
+			},
+		},
+		{
+			Name: "TLS 1.1 allowed by allowed_versions",
+			Content: `
+resource "azurerm_cosmosdb_account" "example" {
+  minimal_tls_version = "Tls11"
+}`,
+			Config: `
+rule "azurerm_cosmosdb_account_minimal_tls_version" {
+  enabled          = true
+  allowed_versions = ["Tls11", "Tls12"]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "TLS 1.2",
+			Content: `
+resource "azurerm_cosmosdb_account" "example" {
+  minimal_tls_version = "Tls12"
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content, ".tflint.hcl": test.Config})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+)
+
+// AzurermCosmosdbAccountNetworkSecurityPerimeterAssociation checks that Cosmos DB accounts have an NSP association
+type AzurermCosmosdbAccountNetworkSecurityPerimeterAssociation struct {
+	tflint.DefaultRule
+
+	resourceType string
+}
+
+// NewAzurermCosmosdbAccountNetworkSecurityPerimeterAssociation returns a new rule instance
+func NewAzurermCosmosdbAccountNetworkSecurityPerimeterAssociation() *AzurermCosmosdbAccountNetworkSecurityPerimeterAssociation {
+	return &AzurermCosmosdbAccountNetworkSecurityPerimeterAssociation{
+		resourceType: "azurerm_cosmosdb_account",
+	}
+}
+
+// Name returns the rule name
+func (r *AzurermCosmosdbAccountNetworkSecurityPerimeterAssociation) Name() string {
+	return "azurerm_cosmosdb_account_network_security_perimeter_association"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AzurermCosmosdbAccountNetworkSecurityPerimeterAssociation) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AzurermCosmosdbAccountNetworkSecurityPerimeterAssociation) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AzurermCosmosdbAccountNetworkSecurityPerimeterAssociation) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks if Cosmos DB accounts have an associated network security perimeter
+func (r *AzurermCosmosdbAccountNetworkSecurityPerimeterAssociation) Check(runner tflint.Runner) error {
+	return checkNetworkSecurityPerimeterAssociation(runner, r, r.resourceType, "Cosmos DB Account")
+}
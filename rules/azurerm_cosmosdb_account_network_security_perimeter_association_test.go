@@ -0,0 +1,96 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermCosmosdbAccountNetworkSecurityPerimeterAssociation(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "without NSP association",
+			Content: `
+resource "azurerm_cosmosdb_account" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAzurermCosmosdbAccountNetworkSecurityPerimeterAssociation(),
+					Message: "Cosmos DB Account 'example' does not have an associated azurerm_network_security_perimeter_association",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 46},
+					},
+				},
+			},
+		},
+		{
+			Name: "with NSP association",
+			Content: `
+resource "azurerm_cosmosdb_account" "example" {
+}
+
+resource "azurerm_network_security_perimeter_association" "example" {
+  resource_id = azurerm_cosmosdb_account.example.id
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "with NSP association through a local value",
+			Content: `
+resource "azurerm_cosmosdb_account" "example" {
+  count = 1
+}
+
+locals {
+  cosmosdb_account_id = one(azurerm_cosmosdb_account.example[*].id)
+}
+
+resource "azurerm_network_security_perimeter_association" "example" {
+  resource_id = local.cosmosdb_account_id
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "NSP association of another resource",
+			Content: `
+resource "azurerm_cosmosdb_account" "example" {
+}
+
+resource "azurerm_network_security_perimeter_association" "example" {
+  resource_id = azurerm_cosmosdb_account.example.name
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAzurermCosmosdbAccountNetworkSecurityPerimeterAssociation(),
+					Message: "Cosmos DB Account 'example' does not have an associated azurerm_network_security_perimeter_association",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 46},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewAzurermCosmosdbAccountNetworkSecurityPerimeterAssociation()
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
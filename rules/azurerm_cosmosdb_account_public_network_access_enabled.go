@@ -0,0 +1,133 @@
+package rules
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+)
+
+// AzurermCosmosdbAccountPublicNetworkAccessEnabled checks that Cosmos DB accounts are not reachable from all networks
+type AzurermCosmosdbAccountPublicNetworkAccessEnabled struct {
+	tflint.DefaultRule
+
+	resourceType  string
+	attributeName string
+}
+
+// NewAzurermCosmosdbAccountPublicNetworkAccessEnabled returns a new rule instance
+func NewAzurermCosmosdbAccountPublicNetworkAccessEnabled() *AzurermCosmosdbAccountPublicNetworkAccessEnabled {
+	return &AzurermCosmosdbAccountPublicNetworkAccessEnabled{
+		resourceType:  "azurerm_cosmosdb_account",
+		attributeName: "public_network_access_enabled",
+	}
+}
+
+// Name returns the rule name
+func (r *AzurermCosmosdbAccountPublicNetworkAccessEnabled) Name() string {
+	return "azurerm_cosmosdb_account_public_network_access_enabled"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AzurermCosmosdbAccountPublicNetworkAccessEnabled) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AzurermCosmosdbAccountPublicNetworkAccessEnabled) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AzurermCosmosdbAccountPublicNetworkAccessEnabled) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks that public network access is disabled or restricted by the firewall of the account,
+// either to virtual networks with is_virtual_network_filter_enabled or to IP ranges with ip_range_filter
+func (r *AzurermCosmosdbAccountPublicNetworkAccessEnabled) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.attributeName},
+			{Name: "is_virtual_network_filter_enabled"},
+			{Name: "ip_range_filter"},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		restricted, err := r.firewallEnabled(runner, resource)
+		if err != nil {
+			return err
+		}
+		if restricted {
+			continue
+		}
+
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			if err := runner.EmitIssueWithFix(
+				r,
+				"public_network_access_enabled is not defined and defaults to true, consider disabling it or enabling is_virtual_network_filter_enabled or ip_range_filter",
+				resource.DefRange,
+				fixInsertAttribute(runner, resource, r.attributeName, cty.False),
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := runner.EvaluateExpr(attribute.Expr, func(val bool) error {
+			if !val {
+				return nil
+			}
+			return runner.EmitIssueWithFix(
+				r,
+				"Consider changing public_network_access_enabled to false or enabling is_virtual_network_filter_enabled or ip_range_filter",
+				attribute.Expr.Range(),
+				fixReplaceLiteral(attribute, cty.False),
+			)
+		}, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// firewallEnabled returns whether the account only accepts public traffic from virtual networks or IP ranges.
+// Values that cannot be evaluated, such as unknown variables, are considered restricted.
+func (r *AzurermCosmosdbAccountPublicNetworkAccessEnabled) firewallEnabled(runner tflint.Runner, resource *hclext.Block) (bool, error) {
+	if attribute, exists := resource.Body.Attributes["is_virtual_network_filter_enabled"]; exists {
+		enabled := true
+		if err := runner.EvaluateExpr(attribute.Expr, func(val bool) error {
+			enabled = val
+			return nil
+		}, nil); err != nil {
+			return false, err
+		}
+		if enabled {
+			return true, nil
+		}
+	}
+
+	attribute, exists := resource.Body.Attributes["ip_range_filter"]
+	if !exists {
+		return false, nil
+	}
+	values, known, err := evaluateStringList(runner, attribute.Expr)
+	if err != nil {
+		return false, err
+	}
+	if !known {
+		return true, nil
+	}
+	// ip_range_filter is a comma-separated string with azurerm v3
+	return slices.ContainsFunc(values, func(value string) bool { return strings.TrimSpace(value) != "" }), nil
+}
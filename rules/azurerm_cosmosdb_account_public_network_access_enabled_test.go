@@ -0,0 +1,123 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermCosmosdbAccountPublicNetworkAccessEnabled(t *testing.T) {
+	rule := NewAzurermCosmosdbAccountPublicNetworkAccessEnabled()
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "public network access missing",
+			Content: `
+resource "azurerm_cosmosdb_account" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "public_network_access_enabled is not defined and defaults to true, consider disabling it or enabling is_virtual_network_filter_enabled or ip_range_filter",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 46},
+					},
+				},
+			},
+		},
+		{
+			Name: "public network access enabled",
+			Content: `
+resource "azurerm_cosmosdb_account" "example" {
+  public_network_access_enabled     = true
+  is_virtual_network_filter_enabled = false
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "Consider changing public_network_access_enabled to false or enabling is_virtual_network_filter_enabled or ip_range_filter",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 39},
+						End:      hcl.Pos{Line: 3, Column: 43},
+					},
+				},
+			},
+		},
+		{
+			Name: "public network access disabled",
+			Content: `
+resource "azurerm_cosmosdb_account" "example" {
+  public_network_access_enabled = false
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "virtual network filter",
+			Content: `
+resource "azurerm_cosmosdb_account" "example" {
+  is_virtual_network_filter_enabled = true
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "IP range filter",
+			Content: `
+resource "azurerm_cosmosdb_account" "example" {
+  ip_range_filter = ["203.0.113.0/24"]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "sensitive IP range filter",
+			Content: `
+resource "azurerm_cosmosdb_account" "example" {
+  ip_range_filter = var.ip_range_filter
+}
+
+variable "ip_range_filter" {
+  default   = "203.0.113.0/24"
+  sensitive = true
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "empty azurerm v3 IP range filter",
+			Content: `
+resource "azurerm_cosmosdb_account" "example" {
+  public_network_access_enabled = true
+  ip_range_filter               = ""
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "Consider changing public_network_access_enabled to false or enabling is_virtual_network_filter_enabled or ip_range_filter",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 35},
+						End:      hcl.Pos{Line: 3, Column: 39},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermEventhubAuthorizationRuleManage(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_eventhub_authorization_rule_manage")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "event hub authorization rule without manage rights",
+			Content: `
+resource "azurerm_eventhub_authorization_rule" "example" {
+  eventhub_name = "example"
+  send          = true
+  manage        = false
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+)
+
+// eventhubConnectionStringResourceTypes are the resource and data source types exporting Event Hub connection strings
+var eventhubConnectionStringResourceTypes = []string{
+	"azurerm_eventhub_namespace",
+	"azurerm_eventhub_namespace_authorization_rule",
+	"azurerm_eventhub_authorization_rule",
+}
+
+// AzurermEventhubConnectionString checks that Event Hub connection strings are not passed to outputs or Key Vault secrets
+type AzurermEventhubConnectionString struct {
+	tflint.DefaultRule
+
+	resourceType string
+}
+
+// NewAzurermEventhubConnectionString returns a new rule instance
+func NewAzurermEventhubConnectionString() *AzurermEventhubConnectionString {
+	return &AzurermEventhubConnectionString{
+		resourceType: "azurerm_key_vault_secret",
+	}
+}
+
+// Name returns the rule name
+func (r *AzurermEventhubConnectionString) Name() string {
+	return "azurerm_eventhub_connection_string"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AzurermEventhubConnectionString) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AzurermEventhubConnectionString) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AzurermEventhubConnectionString) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks the value of outputs and azurerm_key_vault_secret resources for references to Event Hub connection strings
+func (r *AzurermEventhubConnectionString) Check(runner tflint.Runner) error {
+	valueSchema := &hclext.BodySchema{Attributes: []hclext.AttributeSchema{{Name: "value"}}}
+
+	outputs, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{{Type: "output", LabelNames: []string{"name"}, Body: valueSchema}},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+	for _, output := range outputs.Blocks {
+		if err := r.checkValue(runner, fmt.Sprintf("output '%s'", output.Labels[0]), output); err != nil {
+			return err
+		}
+	}
+
+	secrets, err := runner.GetResourceContent(r.resourceType, valueSchema, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+	for _, secret := range secrets.Blocks {
+		if err := r.checkValue(runner, fmt.Sprintf("%s '%s'", r.resourceType, secret.Labels[1]), secret); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkValue reports the value attribute of the block when it references a connection string.
+// subject names the block in the issue message.
+func (r *AzurermEventhubConnectionString) checkValue(runner tflint.Runner, subject string, block *hclext.Block) error {
+	attribute, exists := block.Body.Attributes["value"]
+	if !exists {
+		return nil
+	}
+	for _, name := range eventhubConnectionStrings(attribute.Expr) {
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("%s passes the Event Hub connection string %s, consider granting a managed identity access instead", subject, name),
+			attribute.Expr.Range(),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// eventhubConnectionStrings returns the Event Hub connection string attributes referenced by the expression,
+// such as primary_connection_string of an azurerm_eventhub_namespace_authorization_rule resource or data source
+func eventhubConnectionStrings(expr hcl.Expression) []string {
+	var names []string
+	for _, traversal := range expr.Variables() {
+		steps := traversal.SimpleSplit().Rel
+		resourceType := traversal.RootName()
+		if resourceType == "data" {
+			if len(steps) == 0 {
+				continue
+			}
+			attr, ok := steps[0].(hcl.TraverseAttr)
+			if !ok {
+				continue
+			}
+			resourceType, steps = attr.Name, steps[1:]
+		}
+		if !slices.Contains(eventhubConnectionStringResourceTypes, resourceType) || len(steps) == 0 {
+			continue
+		}
+
+		// The first step is the resource name
+		for _, step := range steps[1:] {
+			attr, ok := step.(hcl.TraverseAttr)
+			if ok && strings.Contains(attr.Name, "connection_string") && !slices.Contains(names, attr.Name) {
+				names = append(names, attr.Name)
+			}
+		}
+	}
+	return names
+}
@@ -0,0 +1,105 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermEventhubConnectionString(t *testing.T) {
+	rule := NewAzurermEventhubConnectionString()
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "output passing a connection string",
+			Content: `
+output "connection_string" {
+  value     = azurerm_eventhub_namespace_authorization_rule.example.primary_connection_string
+  sensitive = true
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "output 'connection_string' passes the Event Hub connection string primary_connection_string, consider granting a managed identity access instead",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 15},
+						End:      hcl.Pos{Line: 3, Column: 94},
+					},
+				},
+			},
+		},
+		{
+			Name: "Key Vault secret storing the default connection string of a namespace",
+			Content: `
+resource "azurerm_key_vault_secret" "example" {
+  name         = "eventhub"
+  key_vault_id = azurerm_key_vault.example.id
+  value        = azurerm_eventhub_namespace.example[0].default_primary_connection_string
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "azurerm_key_vault_secret 'example' passes the Event Hub connection string default_primary_connection_string, consider granting a managed identity access instead",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 18},
+						End:      hcl.Pos{Line: 5, Column: 89},
+					},
+				},
+			},
+		},
+		{
+			Name: "output passing the connection string of a data source",
+			Content: `
+output "connection_string" {
+  value = "${data.azurerm_eventhub_authorization_rule.example.secondary_connection_string};EntityPath=example"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "output 'connection_string' passes the Event Hub connection string secondary_connection_string, consider granting a managed identity access instead",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 11},
+						End:      hcl.Pos{Line: 3, Column: 111},
+					},
+				},
+			},
+		},
+		{
+			Name: "outputs and secrets without connection strings",
+			Content: `
+resource "azurerm_eventhub_namespace" "connection_string" {
+}
+
+output "namespace_id" {
+  value = azurerm_eventhub_namespace.connection_string.id
+}
+
+resource "azurerm_key_vault_secret" "example" {
+  name         = "storage"
+  key_vault_id = azurerm_key_vault.example.id
+  value        = azurerm_storage_account.example.primary_connection_string
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
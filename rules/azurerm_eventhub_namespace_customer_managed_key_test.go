@@ -0,0 +1,85 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermEventhubNamespaceCustomerManagedKey(t *testing.T) {
+	rule := findRule[*CustomerManagedKey](t, NewCustomerManagedKeyRules(), "azurerm_eventhub_namespace_customer_managed_key")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Premium Event Hub namespace without key",
+			Content: `
+resource "azurerm_eventhub_namespace" "example" {
+  sku = "Premium"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "Event Hub Namespace is not encrypted with a customer-managed key, an azurerm_eventhub_namespace_customer_managed_key should set key_vault_key_ids",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 48},
+					},
+				},
+			},
+		},
+		{
+			Name: "Premium Event Hub namespace with key",
+			Content: `
+resource "azurerm_eventhub_namespace" "example" {
+  sku = "Premium"
+}
+
+resource "azurerm_eventhub_namespace_customer_managed_key" "example" {
+  eventhub_namespace_id = azurerm_eventhub_namespace.example.id
+  key_vault_key_ids     = [azurerm_key_vault_key.example.versionless_id]
+}
+
+resource "azurerm_key_vault_key" "example" {
+  rotation_policy {
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "customer-managed key azurerm_key_vault_key.example is not rotated automatically, expire_after is missing in rotation_policy block",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 8, Column: 27},
+						End:      hcl.Pos{Line: 8, Column: 73},
+					},
+				},
+			},
+		},
+		{
+			Name: "Standard Event Hub namespace",
+			Content: `
+resource "azurerm_eventhub_namespace" "example" {
+  sku = "Standard"
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
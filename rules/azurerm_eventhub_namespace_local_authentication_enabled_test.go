@@ -0,0 +1,74 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermEventhubNamespaceLocalAuthenticationEnabled(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_eventhub_namespace_local_authentication_enabled")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "local authentication missing",
+			Content: `
+resource "azurerm_eventhub_namespace" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "local_authentication_enabled is not defined and defaults to true, should be false",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 48},
+					},
+				},
+			},
+		},
+		{
+			Name: "local authentication enabled",
+			Content: `
+resource "azurerm_eventhub_namespace" "example" {
+  local_authentication_enabled = true
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "local_authentication_enabled should be false, shared access keys bypass Microsoft Entra authorization",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 34},
+						End:      hcl.Pos{Line: 3, Column: 38},
+					},
+				},
+			},
+		},
+		{
+			Name: "local authentication disabled",
+			Content: `
+resource "azurerm_eventhub_namespace" "example" {
+  local_authentication_enabled = false
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
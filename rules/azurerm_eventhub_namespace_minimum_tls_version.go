@@ -5,6 +5,7 @@ import (
 
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
 
 	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
 )
@@ -50,8 +51,21 @@ func (r *AzurermEventhubNamespaceUnsecureTLS) Link() string {
 	return project.ReferenceLink(r.Name())
 }
 
+// ApplyConfig applies the plugin-wide minimum TLS version
+func (r *AzurermEventhubNamespaceUnsecureTLS) ApplyConfig(config *Config) {
+	r.enum = tlsVersionsAtLeast(config.MinimumTLSVersion, eventhubTLSVersions)
+}
+
 // Check checks the pattern is valid
 func (r *AzurermEventhubNamespaceUnsecureTLS) Check(runner tflint.Runner) error {
+	config := tlsVersionRuleConfig{AllowedVersions: r.enum}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+	if len(config.AllowedVersions) == 0 {
+		return fmt.Errorf("allowed_versions must not be empty")
+	}
+
 	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
 		Attributes: []hclext.AttributeSchema{
 			{Name: r.attributeName},
@@ -68,17 +82,20 @@ func (r *AzurermEventhubNamespaceUnsecureTLS) Check(runner tflint.Runner) error
 		}
 		err := runner.EvaluateExpr(attribute.Expr, func(val string) error {
 			found := false
-			for _, item := range r.enum {
+			for _, item := range config.AllowedVersions {
 				if item == val {
 					found = true
 				}
 			}
 			if !found {
-				runner.EmitIssue(
+				if err := runner.EmitIssueWithFix(
 					r,
 					fmt.Sprintf(`"%s" is an insecure value as min_tls_version`, val),
 					attribute.Expr.Range(),
-				)
+					fixReplaceLiteral(attribute, cty.StringVal(config.AllowedVersions[0])),
+				); err != nil {
+					return err
+				}
 			}
 			return nil
 		}, nil)
@@ -115,6 +115,86 @@ resource "azurerm_network_security_perimeter_association" "example2" {
 }`,
 			Expected: helper.Issues{},
 		},
+		{
+			Name: "eventhub namespace with for_each and NSP association indexed by each.key",
+			Content: `
+resource "azurerm_eventhub_namespace" "example" {
+  for_each = var.names
+}
+
+resource "azurerm_network_security_perimeter_association" "example" {
+  for_each    = var.names
+  resource_id = azurerm_eventhub_namespace.example[each.key].id
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "eventhub namespace with for_each and NSP association iterating over it",
+			Content: `
+resource "azurerm_eventhub_namespace" "example" {
+  for_each = toset(["a", "b"])
+}
+
+resource "azurerm_network_security_perimeter_association" "example" {
+  for_each    = azurerm_eventhub_namespace.example
+  resource_id = each.value.id
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "eventhub namespace with count and NSP association using a splat",
+			Content: `
+resource "azurerm_eventhub_namespace" "example" {
+  count = 2
+}
+
+resource "azurerm_network_security_perimeter_association" "example" {
+  count       = 2
+  resource_id = azurerm_eventhub_namespace.example[*].id[count.index]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "eventhub namespace with for_each without NSP association",
+			Content: `
+resource "azurerm_eventhub_namespace" "example" {
+  for_each = toset(["a", "b", "c"])
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAzurermEventhubNamespaceNetworkSecurityPerimeterAssociation(),
+					Message: "EventHub Namespace 'example' does not have an associated azurerm_network_security_perimeter_association",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 48},
+					},
+				},
+			},
+		},
+		{
+			Name: "NSP association iterating over another resource with each.value",
+			Content: `
+resource "azurerm_eventhub_namespace" "example" {
+  for_each = toset(["a"])
+}
+
+resource "azurerm_network_security_perimeter_association" "example" {
+  for_each    = var.ids
+  resource_id = each.value
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAzurermEventhubNamespaceNetworkSecurityPerimeterAssociation(),
+					Message: "EventHub Namespace 'example' does not have an associated azurerm_network_security_perimeter_association",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 48},
+					},
+				},
+			},
+		},
 		{
 			Name: "no eventhub namespaces defined",
 			Content: `
@@ -1,8 +1,12 @@
 package rules
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
 	
 	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
 )
@@ -45,6 +49,12 @@ func (r *AzurermEventhubNamespacePublicNetworkAccessEnabled) Link() string {
 
 // Check checks if transparent data encryption is enabled
 func (r *AzurermEventhubNamespacePublicNetworkAccessEnabled) Check(runner tflint.Runner) error {
+	expected, err := decodeDefaultActionRuleConfig(runner, r.Name(), []string{"Deny"})
+	if err != nil {
+		return err
+	}
+	defaultActionExpected := expected[0]
+
 	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
 		Attributes: []hclext.AttributeSchema{
 			{Name: r.attributeName},
@@ -91,12 +101,15 @@ func (r *AzurermEventhubNamespacePublicNetworkAccessEnabled) Check(runner tflint
 						if err := runner.EvaluateExpr(actionAttr.Expr, &defaultAction, nil); err != nil {
 							return err
 						}
-						if defaultAction == "Allow" {
-							runner.EmitIssue(
+						if defaultAction != defaultActionExpected {
+							if err := runner.EmitIssueWithFix(
 								r,
-								"public_network_access_enabled is true and network_rulesets block with default_action = Allow, Consider changing the default_action to deny",
+								fmt.Sprintf("public_network_access_enabled is true and network_rulesets block with default_action = %s, Consider changing the default_action to %s", defaultAction, strings.ToLower(defaultActionExpected)),
 								actionAttr.Expr.Range(),
-							)
+								fixReplaceLiteral(actionAttr, cty.StringVal(defaultActionExpected)),
+							); err != nil {
+								return err
+							}
 							issueEmitted = true
 						} else {
 							return nil
@@ -106,11 +119,18 @@ func (r *AzurermEventhubNamespacePublicNetworkAccessEnabled) Check(runner tflint
 			}
 
 			if !issueEmitted {
-				runner.EmitIssue(
+				fix := fixInsertAttribute(runner, resource, r.attributeName, cty.False)
+				if exists {
+					fix = fixReplaceLiteral(attribute, cty.False)
+				}
+				if err := runner.EmitIssueWithFix(
 					r,
-					"public_network_access_enabled is not defined and defaults to true, consider disabling it or add network_rulesets block with default_action = Deny",
+					fmt.Sprintf("public_network_access_enabled is not defined and defaults to true, consider disabling it or add network_rulesets block with default_action = %s", defaultActionExpected),
 					resource.DefRange,
-				)
+					fix,
+				); err != nil {
+					return err
+				}
 				continue
 			}
 		}
@@ -11,6 +11,7 @@ func Test_AzurermEventhubNamespacePublicNetworkAccessEnabled(t *testing.T) {
 	tests := []struct {
 		Name     string
 		Content  string
+		Config   string
 		Expected helper.Issues
 	}{
 		{
@@ -104,6 +105,22 @@ resource "azurerm_eventhub_namespace" "example" {
 	network_rulesets {
 	    default_action = "Deny"
 	}
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "default action of the rule block",
+			Content: `
+resource "azurerm_eventhub_namespace" "example" {
+    public_network_access_enabled = true
+    network_rulesets {
+        default_action = "Allow"
+    }
+}`,
+			Config: `
+rule "azurerm_eventhub_namespace_public_network_access_enabled" {
+    enabled        = true
+    default_action = "Allow"
 }`,
 			Expected: helper.Issues{},
 		},
@@ -113,7 +130,7 @@ resource "azurerm_eventhub_namespace" "example" {
 
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
-			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content, ".tflint.hcl": test.Config})
 
 			if err := rule.Check(runner); err != nil {
 				t.Fatalf("Unexpected error occurred: %s", err)
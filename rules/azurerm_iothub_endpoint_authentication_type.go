@@ -0,0 +1,96 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+)
+
+// AzurermIoTHubEndpointAuthenticationType checks that the inline endpoint blocks of IoT Hubs use identity-based authentication
+type AzurermIoTHubEndpointAuthenticationType struct {
+	tflint.DefaultRule
+
+	resourceType  string
+	attributeName string
+}
+
+// NewAzurermIoTHubEndpointAuthenticationType returns a new rule instance
+func NewAzurermIoTHubEndpointAuthenticationType() *AzurermIoTHubEndpointAuthenticationType {
+	return &AzurermIoTHubEndpointAuthenticationType{
+		resourceType:  "azurerm_iothub",
+		attributeName: "authentication_type",
+	}
+}
+
+// Name returns the rule name
+func (r *AzurermIoTHubEndpointAuthenticationType) Name() string {
+	return "azurerm_iothub_endpoint_authentication_type"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AzurermIoTHubEndpointAuthenticationType) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AzurermIoTHubEndpointAuthenticationType) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *AzurermIoTHubEndpointAuthenticationType) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks that every endpoint block sets authentication_type to "identityBased", it defaults to "keyBased"
+func (r *AzurermIoTHubEndpointAuthenticationType) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "endpoint",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: r.attributeName}},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		for _, endpoint := range resource.Body.Blocks.OfType("endpoint") {
+			attribute, exists := endpoint.Body.Attributes[r.attributeName]
+			if !exists {
+				if err := runner.EmitIssueWithFix(
+					r,
+					`authentication_type is missing in endpoint and defaults to "keyBased", should be "identityBased"`,
+					endpoint.DefRange,
+					fixInsertAttribute(runner, endpoint, r.attributeName, cty.StringVal("identityBased")),
+				); err != nil {
+					return err
+				}
+				continue
+			}
+
+			err := runner.EvaluateExpr(attribute.Expr, func(val string) error {
+				if val == "identityBased" {
+					return nil
+				}
+				return runner.EmitIssueWithFix(
+					r,
+					`authentication_type should be "identityBased"`,
+					attribute.Expr.Range(),
+					fixReplaceLiteral(attribute, cty.StringVal("identityBased")),
+				)
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
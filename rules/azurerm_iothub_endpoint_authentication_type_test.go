@@ -0,0 +1,102 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermIoTHubEndpointAuthenticationType(t *testing.T) {
+	rule := NewAzurermIoTHubEndpointAuthenticationType()
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "inline endpoints",
+			Content: `
+resource "azurerm_iothub" "example" {
+  endpoint {
+    type = "AzureIotHub.StorageContainer"
+    name = "export"
+  }
+
+  endpoint {
+    type                = "AzureIotHub.ServiceBusQueue"
+    name                = "queue"
+    authentication_type = "keyBased"
+  }
+
+  endpoint {
+    type                = "AzureIotHub.EventHub"
+    name                = "events"
+    authentication_type = "identityBased"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "authentication_type is missing in endpoint and defaults to \"keyBased\", should be \"identityBased\"",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 11},
+					},
+				},
+				{
+					Rule:    rule,
+					Message: "authentication_type should be \"identityBased\"",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 11, Column: 27},
+						End:      hcl.Pos{Line: 11, Column: 37},
+					},
+				},
+			},
+		},
+		{
+			Name: "no inline endpoints",
+			Content: `
+resource "azurerm_iothub" "example" {
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
+
+func Test_AzurermIoTHubEndpointAuthenticationTypeFix(t *testing.T) {
+	runner := helper.TestRunner(t, map[string]string{"resource.tf": `
+resource "azurerm_iothub" "example" {
+  endpoint {
+    name                = "queue"
+    authentication_type = "keyBased"
+  }
+}`})
+
+	if err := NewAzurermIoTHubEndpointAuthenticationType().Check(runner); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	helper.AssertChanges(t, map[string]string{"resource.tf": `
+resource "azurerm_iothub" "example" {
+  endpoint {
+    name                = "queue"
+    authentication_type = "identityBased"
+  }
+}`}, runner.Changes())
+}
@@ -0,0 +1,38 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermIoTHubEndpointServicebusTopicAuthenticationType(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_iothub_endpoint_servicebus_topic_authentication_type")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Service Bus topic endpoint using a managed identity",
+			Content: `
+resource "azurerm_iothub_endpoint_servicebus_topic" "example" {
+  authentication_type = "identityBased"
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermIoTHubEndpointStorageContainerAuthenticationType(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_iothub_endpoint_storage_container_authentication_type")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "storage container endpoint using keys",
+			Content: `
+resource "azurerm_iothub_endpoint_storage_container" "example" {
+  authentication_type = "keyBased"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "authentication_type should be \"identityBased\"",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 25},
+						End:      hcl.Pos{Line: 3, Column: 35},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermIoTHubMinTLSVersion(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_iothub_min_tls_version")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "TLS version missing",
+			Content: `
+resource "azurerm_iothub" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "min_tls_version is not defined and TLS 1.0 and 1.1 are accepted, should be set to 1.2",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 36},
+					},
+				},
+			},
+		},
+		{
+			Name: "TLS 1.2",
+			Content: `
+resource "azurerm_iothub" "example" {
+  min_tls_version = "1.2"
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
+
+func Test_AzurermIoTHubMinTLSVersionFix(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_iothub_min_tls_version")
+
+	tests := []struct {
+		Name    string
+		Content string
+	}{
+		{
+			Name: "min_tls_version below 1.2",
+			Content: `
+resource "azurerm_iothub" "example" {
+  min_tls_version = "1.0"
+}`,
+		},
+		{
+			Name: "min_tls_version attribute missing",
+			Content: `
+resource "azurerm_iothub" "example" {
+}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertChanges(t, map[string]string{}, runner.Changes())
+		})
+	}
+}
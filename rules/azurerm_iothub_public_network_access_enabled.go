@@ -0,0 +1,130 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+)
+
+// AzurermIoTHubPublicNetworkAccessEnabled checks that IoT Hubs are not reachable from all networks
+type AzurermIoTHubPublicNetworkAccessEnabled struct {
+	tflint.DefaultRule
+
+	resourceType  string
+	attributeName string
+}
+
+// NewAzurermIoTHubPublicNetworkAccessEnabled returns a new rule instance
+func NewAzurermIoTHubPublicNetworkAccessEnabled() *AzurermIoTHubPublicNetworkAccessEnabled {
+	return &AzurermIoTHubPublicNetworkAccessEnabled{
+		resourceType:  "azurerm_iothub",
+		attributeName: "public_network_access_enabled",
+	}
+}
+
+// Name returns the rule name
+func (r *AzurermIoTHubPublicNetworkAccessEnabled) Name() string {
+	return "azurerm_iothub_public_network_access_enabled"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AzurermIoTHubPublicNetworkAccessEnabled) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AzurermIoTHubPublicNetworkAccessEnabled) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *AzurermIoTHubPublicNetworkAccessEnabled) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks that public network access is disabled or denied by default in the network_rule_set block.
+// A network_rule_set block allowing by default is reported on its default_action.
+func (r *AzurermIoTHubPublicNetworkAccessEnabled) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.attributeName},
+		},
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "network_rule_set",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "default_action"}},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if exists {
+			enabled := false
+			if err := runner.EvaluateExpr(attribute.Expr, func(val bool) error {
+				enabled = val
+				return nil
+			}, nil); err != nil {
+				return err
+			}
+			if !enabled {
+				continue
+			}
+		}
+
+		if blocks := resource.Body.Blocks.OfType("network_rule_set"); len(blocks) > 0 {
+			if err := r.checkNetworkRuleSet(runner, blocks[0]); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if !exists {
+			err = runner.EmitIssueWithFix(
+				r,
+				"public_network_access_enabled is not defined and defaults to true, consider disabling it or add network_rule_set block with default_action = DefaultActionDeny",
+				resource.DefRange,
+				fixInsertAttribute(runner, resource, r.attributeName, cty.False),
+			)
+		} else {
+			err = runner.EmitIssueWithFix(
+				r,
+				"Consider changing public_network_access_enabled to false or add network_rule_set block with default_action = DefaultActionDeny",
+				attribute.Expr.Range(),
+				fixReplaceLiteral(attribute, cty.False),
+			)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkNetworkRuleSet reports a network_rule_set block allowing the networks it does not list.
+// default_action defaults to DefaultActionDeny.
+func (r *AzurermIoTHubPublicNetworkAccessEnabled) checkNetworkRuleSet(runner tflint.Runner, block *hclext.Block) error {
+	attribute, exists := block.Body.Attributes["default_action"]
+	if !exists {
+		return nil
+	}
+	return runner.EvaluateExpr(attribute.Expr, func(val string) error {
+		if val != "DefaultActionAllow" {
+			return nil
+		}
+		return runner.EmitIssueWithFix(
+			r,
+			"network_rule_set allows all networks with default_action = DefaultActionAllow, consider changing it to DefaultActionDeny",
+			attribute.Expr.Range(),
+			fixReplaceLiteral(attribute, cty.StringVal("DefaultActionDeny")),
+		)
+	}, nil)
+}
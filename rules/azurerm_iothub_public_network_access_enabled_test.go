@@ -0,0 +1,111 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermIoTHubPublicNetworkAccessEnabled(t *testing.T) {
+	rule := NewAzurermIoTHubPublicNetworkAccessEnabled()
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "public network access missing",
+			Content: `
+resource "azurerm_iothub" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "public_network_access_enabled is not defined and defaults to true, consider disabling it or add network_rule_set block with default_action = DefaultActionDeny",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 36},
+					},
+				},
+			},
+		},
+		{
+			Name: "public network access enabled",
+			Content: `
+resource "azurerm_iothub" "example" {
+  public_network_access_enabled = true
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "Consider changing public_network_access_enabled to false or add network_rule_set block with default_action = DefaultActionDeny",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 35},
+						End:      hcl.Pos{Line: 3, Column: 39},
+					},
+				},
+			},
+		},
+		{
+			Name: "network rule set allowing by default",
+			Content: `
+resource "azurerm_iothub" "example" {
+  network_rule_set {
+    default_action = "DefaultActionAllow"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "network_rule_set allows all networks with default_action = DefaultActionAllow, consider changing it to DefaultActionDeny",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 22},
+						End:      hcl.Pos{Line: 4, Column: 42},
+					},
+				},
+			},
+		},
+		{
+			Name: "network rule set denying by default",
+			Content: `
+resource "azurerm_iothub" "example" {
+  network_rule_set {
+    ip_rule {
+      name    = "devices"
+      ip_mask = "203.0.113.0/24"
+    }
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "public network access disabled",
+			Content: `
+resource "azurerm_iothub" "example" {
+  public_network_access_enabled = false
+
+  network_rule_set {
+    default_action = "DefaultActionAllow"
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -20,6 +20,11 @@ type AzurermKeyVaultCertificateLifetimeAction struct {
 	validValues   []string
 }
 
+// azurermKeyVaultCertificateLifetimeActionConfig is the `rule` block of the rule
+type azurermKeyVaultCertificateLifetimeActionConfig struct {
+	AllowedActionTypes []string `hclext:"allowed_action_types,optional"`
+}
+
 // NewAzurermKeyVaultCertificateLifetimeAction returns a new rule instance
 func NewAzurermKeyVaultCertificateLifetimeAction() *AzurermKeyVaultCertificateLifetimeAction {
 	return &AzurermKeyVaultCertificateLifetimeAction{
@@ -51,6 +56,11 @@ func (r *AzurermKeyVaultCertificateLifetimeAction) Link() string {
 
 // Check verifies that the certificate policy lifetime action is properly configured
 func (r *AzurermKeyVaultCertificateLifetimeAction) Check(runner tflint.Runner) error {
+	config := azurermKeyVaultCertificateLifetimeActionConfig{AllowedActionTypes: r.validValues}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
 	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
 		Blocks: []hclext.BlockSchema{
 			{
@@ -81,6 +91,8 @@ func (r *AzurermKeyVaultCertificateLifetimeAction) Check(runner tflint.Runner) e
 		return err
 	}
 
+	allowedActionTypes := strings.Join(config.AllowedActionTypes, " or ")
+
 	for _, resource := range resources.Blocks {
 		certPolicyBlocks := resource.Body.Blocks.OfType("certificate_policy")
 		if len(certPolicyBlocks) == 0 {
@@ -119,7 +131,7 @@ func (r *AzurermKeyVaultCertificateLifetimeAction) Check(runner tflint.Runner) e
 		if !exists {
 			runner.EmitIssue(
 				r,
-				"action_type is missing in action block, should be set to either AutoRenew or EmailContacts",
+				fmt.Sprintf("action_type is missing in action block, should be set to either %s", allowedActionTypes),
 				action.DefRange,
 			)
 			continue
@@ -127,7 +139,7 @@ func (r *AzurermKeyVaultCertificateLifetimeAction) Check(runner tflint.Runner) e
 
 		err := runner.EvaluateExpr(attribute.Expr, func(val string) error {
 			valid := false
-			for _, validValue := range r.validValues {
+			for _, validValue := range config.AllowedActionTypes {
 				if strings.EqualFold(val, validValue) {
 					valid = true
 					break
@@ -136,7 +148,7 @@ func (r *AzurermKeyVaultCertificateLifetimeAction) Check(runner tflint.Runner) e
 			if !valid {
 				runner.EmitIssue(
 					r,
-					fmt.Sprintf("action_type is set to %s, should be set to either AutoRenew or EmailContacts", val),
+					fmt.Sprintf("action_type is set to %s, should be set to either %s", val, allowedActionTypes),
 					attribute.Expr.Range(),
 				)
 			}
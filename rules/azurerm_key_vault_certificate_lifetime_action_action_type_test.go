@@ -11,6 +11,7 @@ func Test_AzurermKeyVaultCertificateLifetimeAction(t *testing.T) {
 	tests := []struct {
 		Name     string
 		Content  string
+		Config   string
 		Expected helper.Issues
 	}{
 		{
@@ -175,13 +176,48 @@ resource "azurerm_key_vault_certificate" "example" {
 				},
 			},
 		},
+		{
+			Name: "action_type not in configured allowed_action_types",
+			Content: `
+resource "azurerm_key_vault_certificate" "example" {
+    certificate_policy {
+        lifetime_action {
+            action {
+                action_type = "EmailContacts"
+            }
+        }
+    }
+}`,
+			Config: `
+rule "azurerm_key_vault_certificate_lifetime_action" {
+    enabled              = true
+    allowed_action_types = ["AutoRenew"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAzurermKeyVaultCertificateLifetimeAction(),
+					Message: "action_type is set to EmailContacts, should be set to either AutoRenew",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start: hcl.Pos{
+							Line:   6,
+							Column: 31,
+						},
+						End: hcl.Pos{
+							Line:   6,
+							Column: 46,
+						},
+					},
+				},
+			},
+		},
 	}
 
 	rule := NewAzurermKeyVaultCertificateLifetimeAction()
 
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
-			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content, ".tflint.hcl": test.Config})
 
 			if err := rule.Check(runner); err != nil {
 				t.Fatalf("Unexpected error occurred: %s", err)
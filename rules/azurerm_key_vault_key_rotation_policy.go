@@ -1,6 +1,7 @@
 package rules
 
 import (
+	"github.com/hashicorp/hcl/v2"
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 	
@@ -45,46 +46,20 @@ func (r *AzurermKeyVaultKeyRotationPolicy) Link() string {
 
 // Check verifies that the key has a rotation_policy block with expire_after set
 func (r *AzurermKeyVaultKeyRotationPolicy) Check(runner tflint.Runner) error {
-	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
-		Blocks: []hclext.BlockSchema{
-			{
-				Type: "rotation_policy",
-				Body: &hclext.BodySchema{
-					Attributes: []hclext.AttributeSchema{
-						{Name: "expire_after"},
-					},
-				},
-			},
-		},
-	}, nil)
+	resources, err := runner.GetResourceContent(r.resourceType, keyVaultKeyRotationPolicySchema, nil)
 	if err != nil {
 		return err
 	}
 
 	for _, resource := range resources.Blocks {
-		rotationPolicyBlocks := resource.Body.Blocks.OfType("rotation_policy")
-		if len(rotationPolicyBlocks) == 0 {
-			runner.EmitIssue(
-				r,
-				"rotation_policy block is missing, should be defined with expire_after property",
-				resource.DefRange,
-			)
-			continue
-		}
-
-		rotationPolicy := rotationPolicyBlocks[0]
-		attribute, exists := rotationPolicy.Body.Attributes["expire_after"]
-		if !exists {
-			runner.EmitIssue(
-				r,
-				"expire_after is missing in rotation_policy block",
-				rotationPolicy.DefRange,
-			)
+		if message, issueRange, ok := keyVaultKeyRotationPolicyIssue(resource); ok {
+			runner.EmitIssue(r, message, issueRange)
 			continue
 		}
 
 		// We don't validate the value of expire_after as it can be any valid duration string
 		// The provider will validate the format ("P90D", "P6M", etc.)
+		attribute := resource.Body.Blocks.OfType("rotation_policy")[0].Body.Attributes["expire_after"]
 		err := runner.EvaluateExpr(attribute.Expr, func(val string) error {
 			return nil
 		}, nil)
@@ -94,4 +69,33 @@ func (r *AzurermKeyVaultKeyRotationPolicy) Check(runner tflint.Runner) error {
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+// keyVaultKeyRotationPolicySchema is the schema of the rotation policy of azurerm_key_vault_key
+var keyVaultKeyRotationPolicySchema = &hclext.BodySchema{
+	Blocks: []hclext.BlockSchema{
+		{
+			Type: "rotation_policy",
+			Body: &hclext.BodySchema{
+				Attributes: []hclext.AttributeSchema{
+					{Name: "expire_after"},
+				},
+			},
+		},
+	},
+}
+
+// keyVaultKeyRotationPolicyIssue returns the issue message and range of a key read with keyVaultKeyRotationPolicySchema
+// when it has no rotation_policy block with expire_after set
+func keyVaultKeyRotationPolicyIssue(resource *hclext.Block) (string, hcl.Range, bool) {
+	rotationPolicyBlocks := resource.Body.Blocks.OfType("rotation_policy")
+	if len(rotationPolicyBlocks) == 0 {
+		return "rotation_policy block is missing, should be defined with expire_after property", resource.DefRange, true
+	}
+
+	rotationPolicy := rotationPolicyBlocks[0]
+	if _, exists := rotationPolicy.Body.Attributes["expire_after"]; !exists {
+		return "expire_after is missing in rotation_policy block", rotationPolicy.DefRange, true
+	}
+	return "", hcl.Range{}, false
+}
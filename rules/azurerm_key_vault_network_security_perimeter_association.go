@@ -1,10 +1,6 @@
 package rules
 
 import (
-	"fmt"
-
-	"github.com/hashicorp/hcl/v2"
-	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 
 	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
@@ -46,81 +42,5 @@ func (r *AzurermKeyVaultNetworkSecurityPerimeterAssociation) Link() string {
 
 // Check checks if key vaults have an associated network security perimeter
 func (r *AzurermKeyVaultNetworkSecurityPerimeterAssociation) Check(runner tflint.Runner) error {
-	// Get all azurerm_key_vault resources
-	keyVaults, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
-		Attributes: []hclext.AttributeSchema{
-			{Name: "name"},
-		},
-	}, nil)
-	if err != nil {
-		return err
-	}
-
-	// Get all azurerm_network_security_perimeter_association resources
-	nspAssociations, err := runner.GetResourceContent("azurerm_network_security_perimeter_association", &hclext.BodySchema{
-		Attributes: []hclext.AttributeSchema{
-			{Name: "resource_id"},
-		},
-	}, nil)
-	if err != nil {
-		return err
-	}
-
-	// Build a map of key vault resource IDs that have NSP associations
-	associatedKeyVaults := make(map[string]bool)
-
-	for _, assoc := range nspAssociations.Blocks {
-		if resourceIDAttr, exists := assoc.Body.Attributes["resource_id"]; exists {
-			// Check if the resource_id references a key vault
-			vars := resourceIDAttr.Expr.Variables()
-			for _, v := range vars {
-				if len(v) >= 3 {
-					if root, ok := v[0].(hcl.TraverseRoot); ok {
-						if root.Name == "azurerm_key_vault" {
-							if attrTraverse, ok := v[1].(hcl.TraverseAttr); ok {
-								keyVaultName := attrTraverse.Name
-								if len(v) == 3 {
-									if idTraverse, ok := v[2].(hcl.TraverseAttr); ok && idTraverse.Name == "id" {
-										associatedKeyVaults[keyVaultName] = true
-									}
-								} else if len(v) == 4 {
-									if _, ok := v[2].(hcl.TraverseIndex); ok {
-										if idTraverse, ok := v[3].(hcl.TraverseAttr); ok && idTraverse.Name == "id" {
-											associatedKeyVaults[keyVaultName] = true
-										}
-									}
-								}
-							}
-						}
-					}
-				}
-			}
-		}
-	}
-
-	// Check each key vault to see if it has an NSP association
-	for _, keyVault := range keyVaults.Blocks {
-		// Skip key vaults with count or for_each as they may not be created
-		if _, hasCount := keyVault.Body.Attributes["count"]; hasCount {
-			continue
-		}
-		if _, hasForEach := keyVault.Body.Attributes["for_each"]; hasForEach {
-			continue
-		}
-
-		keyVaultLabel := ""
-		if len(keyVault.Labels) > 1 {
-			keyVaultLabel = keyVault.Labels[1]
-		}
-
-		if keyVaultLabel != "" && !associatedKeyVaults[keyVaultLabel] {
-			runner.EmitIssue(
-				r,
-				fmt.Sprintf("Key Vault '%s' does not have an associated azurerm_network_security_perimeter_association", keyVaultLabel),
-				keyVault.DefRange,
-			)
-		}
-	}
-
-	return nil
+	return checkNetworkSecurityPerimeterAssociation(runner, r, r.resourceType, "Key Vault")
 }
@@ -3,6 +3,7 @@ package rules
 import (
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
 	
 	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
 )
@@ -58,21 +59,27 @@ func (r *AzurermKeyVaultRbacDisabled) Check(runner tflint.Runner) error {
 		attribute, exists := resource.Body.Attributes[r.attributeName]
 		if !exists {
 			// Emit an issue if the attribute does not exist
-			runner.EmitIssue(
+			if err := runner.EmitIssueWithFix(
 				r,
 				"enable_rbac_authorization is not defined and defaults to false, consider enabling it",
 				resource.DefRange,
-			)
+				fixInsertAttribute(runner, resource, r.attributeName, cty.True),
+			); err != nil {
+				return err
+			}
 			continue
 		}
 
 		err := runner.EvaluateExpr(attribute.Expr, func(val bool) error {
 			if !val {
-				runner.EmitIssue(
+				if err := runner.EmitIssueWithFix(
 					r,
 					"Consider changing enable_rbac_authorization to true",
 					attribute.Expr.Range(),
-				)
+					fixReplaceLiteral(attribute, cty.True),
+				); err != nil {
+					return err
+				}
 			}
 			return nil
 		}, nil)
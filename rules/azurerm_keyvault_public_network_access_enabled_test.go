@@ -11,6 +11,7 @@ func Test_AzurermKeyVaultPublicNetworkAccessEnabled(t *testing.T) {
 	tests := []struct {
 		Name     string
 		Content  string
+		Config   string
 		Expected helper.Issues
 	}{
 		{
@@ -104,6 +105,22 @@ resource "azurerm_key_vault" "example" {
 	network_acls {
 	    default_action = "Deny"
 	}
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "default action of the rule block",
+			Content: `
+resource "azurerm_key_vault" "example" {
+    public_network_access_enabled = true
+    network_acls {
+        default_action = "Allow"
+    }
+}`,
+			Config: `
+rule "azurerm_key_vault_public_network_access_enabled" {
+    enabled        = true
+    default_action = "Allow"
 }`,
 			Expected: helper.Issues{},
 		},
@@ -113,7 +130,7 @@ resource "azurerm_key_vault" "example" {
 
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
-			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content, ".tflint.hcl": test.Config})
 
 			if err := rule.Check(runner); err != nil {
 				t.Fatalf("Unexpected error occurred: %s", err)
@@ -123,3 +140,45 @@ resource "azurerm_key_vault" "example" {
 		})
 	}
 }
+
+func Test_AzurermKeyVaultPublicNetworkAccessEnabledFix(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected string
+	}{
+		{
+			Name: "rewrite network_acls default_action",
+			Content: `
+resource "azurerm_key_vault" "example" {
+  network_acls {
+    default_action = "Allow"
+  }
+}`,
+			Expected: `
+resource "azurerm_key_vault" "example" {
+  network_acls {
+    default_action = "Deny"
+  }
+}`,
+		},
+	}
+
+	rule := NewAzurermKeyVaultPublicNetworkAccessEnabled()
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			expected := map[string]string{}
+			if test.Expected != "" {
+				expected["resource.tf"] = test.Expected
+			}
+			helper.AssertChanges(t, expected, runner.Changes())
+		})
+	}
+}
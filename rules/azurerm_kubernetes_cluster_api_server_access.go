@@ -0,0 +1,134 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/rules/iprange"
+)
+
+// AzurermKubernetesClusterAPIServerAccess checks that the API server of AKS clusters is private
+// or restricted to authorized IP ranges
+type AzurermKubernetesClusterAPIServerAccess struct {
+	tflint.DefaultRule
+
+	resourceType string
+}
+
+// NewAzurermKubernetesClusterAPIServerAccess returns a new rule instance
+func NewAzurermKubernetesClusterAPIServerAccess() *AzurermKubernetesClusterAPIServerAccess {
+	return &AzurermKubernetesClusterAPIServerAccess{
+		resourceType: "azurerm_kubernetes_cluster",
+	}
+}
+
+// Name returns the rule name
+func (r *AzurermKubernetesClusterAPIServerAccess) Name() string {
+	return "azurerm_kubernetes_cluster_api_server_access"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AzurermKubernetesClusterAPIServerAccess) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AzurermKubernetesClusterAPIServerAccess) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AzurermKubernetesClusterAPIServerAccess) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks that private_cluster_enabled is true or that the authorized IP ranges do not allow all IP addresses.
+// The api_server_authorized_ip_ranges attribute of azurerm v3 is accepted as well.
+func (r *AzurermKubernetesClusterAPIServerAccess) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "private_cluster_enabled"},
+			{Name: "api_server_authorized_ip_ranges"},
+		},
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "api_server_access_profile",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "authorized_ip_ranges"}},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		if attribute, exists := resource.Body.Attributes["private_cluster_enabled"]; exists {
+			private := true
+			if err := runner.EvaluateExpr(attribute.Expr, func(val bool) error {
+				private = val
+				return nil
+			}, nil); err != nil {
+				return err
+			}
+			if private {
+				continue
+			}
+		}
+
+		authorizedIPRanges := []*hclext.Attribute{}
+		if attribute, exists := resource.Body.Attributes["api_server_authorized_ip_ranges"]; exists {
+			authorizedIPRanges = append(authorizedIPRanges, attribute)
+		}
+		for _, profile := range resource.Body.Blocks.OfType("api_server_access_profile") {
+			if attribute, exists := profile.Body.Attributes["authorized_ip_ranges"]; exists {
+				authorizedIPRanges = append(authorizedIPRanges, attribute)
+			}
+		}
+
+		restricted := false
+		for _, attribute := range authorizedIPRanges {
+			values, known, err := evaluateStringList(runner, attribute.Expr)
+			if err != nil {
+				return err
+			}
+			if !known {
+				restricted = true
+				continue
+			}
+
+			for _, value := range values {
+				ipRange, err := iprange.Parse(value)
+				if err != nil {
+					continue
+				}
+				if ipRange.All() {
+					if err := runner.EmitIssue(
+						r,
+						fmt.Sprintf("%s allows access to the API server from all IP addresses (%s)", attribute.Name, value),
+						attribute.Expr.Range(),
+					); err != nil {
+						return err
+					}
+				}
+			}
+			restricted = restricted || len(values) > 0
+		}
+
+		if !restricted {
+			if err := runner.EmitIssue(
+				r,
+				"API server is reachable from all IP addresses, private_cluster_enabled should be true or authorized_ip_ranges should be set in api_server_access_profile",
+				resource.DefRange,
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
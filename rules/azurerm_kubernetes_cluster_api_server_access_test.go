@@ -0,0 +1,130 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermKubernetesClusterAPIServerAccess(t *testing.T) {
+	rule := NewAzurermKubernetesClusterAPIServerAccess()
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "public API server",
+			Content: `
+resource "azurerm_kubernetes_cluster" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "API server is reachable from all IP addresses, private_cluster_enabled should be true or authorized_ip_ranges should be set in api_server_access_profile",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 48},
+					},
+				},
+			},
+		},
+		{
+			Name: "private cluster",
+			Content: `
+resource "azurerm_kubernetes_cluster" "example" {
+  private_cluster_enabled = true
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "private cluster disabled without authorized IP ranges",
+			Content: `
+resource "azurerm_kubernetes_cluster" "example" {
+  private_cluster_enabled = false
+
+  api_server_access_profile {
+    authorized_ip_ranges = []
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "API server is reachable from all IP addresses, private_cluster_enabled should be true or authorized_ip_ranges should be set in api_server_access_profile",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 48},
+					},
+				},
+			},
+		},
+		{
+			Name: "authorized IP ranges",
+			Content: `
+resource "azurerm_kubernetes_cluster" "example" {
+  api_server_access_profile {
+    authorized_ip_ranges = ["203.0.113.0/24"]
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "authorized IP ranges allowing all IP addresses",
+			Content: `
+resource "azurerm_kubernetes_cluster" "example" {
+  api_server_access_profile {
+    authorized_ip_ranges = ["0.0.0.0/0"]
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "authorized_ip_ranges allows access to the API server from all IP addresses (0.0.0.0/0)",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 28},
+						End:      hcl.Pos{Line: 4, Column: 41},
+					},
+				},
+			},
+		},
+		{
+			Name: "azurerm v3 authorized IP ranges",
+			Content: `
+resource "azurerm_kubernetes_cluster" "example" {
+  api_server_authorized_ip_ranges = ["203.0.113.0/24"]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "unknown authorized IP ranges",
+			Content: `
+variable "authorized_ip_ranges" {
+  type = list(string)
+}
+
+resource "azurerm_kubernetes_cluster" "example" {
+  api_server_access_profile {
+    authorized_ip_ranges = var.authorized_ip_ranges
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermKubernetesClusterAutomaticUpgradeChannel(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_kubernetes_cluster_automatic_upgrade_channel")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "automatic upgrade channel none",
+			Content: `
+resource "azurerm_kubernetes_cluster" "example" {
+  automatic_upgrade_channel = "none"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "automatic_upgrade_channel is set to none, should be set to patch or rapid or node-image or stable",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 31},
+						End:      hcl.Pos{Line: 3, Column: 37},
+					},
+				},
+			},
+		},
+		{
+			Name: "automatic upgrade channel with azurerm v3",
+			Content: `
+terraform {
+  required_providers {
+    azurerm = {
+      source  = "hashicorp/azurerm"
+      version = "~> 3.0"
+    }
+  }
+}
+
+resource "azurerm_kubernetes_cluster" "example" {
+  automatic_channel_upgrade = "none"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "automatic_channel_upgrade is set to none, should be set to patch or rapid or node-image or stable",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 12, Column: 31},
+						End:      hcl.Pos{Line: 12, Column: 37},
+					},
+				},
+			},
+		},
+		{
+			Name: "automatic upgrade channel stable with azurerm v3",
+			Content: `
+terraform {
+  required_providers {
+    azurerm = {
+      source  = "hashicorp/azurerm"
+      version = "~> 3.0"
+    }
+  }
+}
+
+resource "azurerm_kubernetes_cluster" "example" {
+  automatic_channel_upgrade = "stable"
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermKubernetesClusterAzurePolicyEnabled(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_kubernetes_cluster_azure_policy_enabled")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Azure Policy disabled",
+			Content: `
+resource "azurerm_kubernetes_cluster" "example" {
+  azure_policy_enabled = false
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "azure_policy_enabled should be true",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 26},
+						End:      hcl.Pos{Line: 3, Column: 31},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermKubernetesClusterAzureRbacEnabled(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_kubernetes_cluster_azure_rbac_enabled")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Azure RBAC block missing",
+			Content: `
+resource "azurerm_kubernetes_cluster" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "azure_active_directory_role_based_access_control block is missing, azure_rbac_enabled should be true",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 48},
+					},
+				},
+			},
+		},
+		{
+			Name: "Azure RBAC attribute missing",
+			Content: `
+resource "azurerm_kubernetes_cluster" "example" {
+  azure_active_directory_role_based_access_control {
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "azure_rbac_enabled is missing in azure_active_directory_role_based_access_control, should be true",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 51},
+					},
+				},
+			},
+		},
+		{
+			Name: "Azure RBAC enabled",
+			Content: `
+resource "azurerm_kubernetes_cluster" "example" {
+  azure_active_directory_role_based_access_control {
+    azure_rbac_enabled = true
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+)
+
+// AzurermKubernetesClusterMonitoring checks that AKS clusters send their logs to Container insights
+// and are protected by Microsoft Defender for Containers
+type AzurermKubernetesClusterMonitoring struct {
+	tflint.DefaultRule
+
+	resourceType string
+}
+
+// kubernetesClusterMonitoringBlocks are the blocks required by the monitoring rule
+// and the messages reported when they are missing
+var kubernetesClusterMonitoringBlocks = []struct {
+	Type    string
+	Message string
+}{
+	{Type: "oms_agent", Message: "oms_agent block is missing, Container insights should send the cluster logs to a Log Analytics workspace"},
+	{Type: "microsoft_defender", Message: "microsoft_defender block is missing, Microsoft Defender for Containers should be enabled"},
+}
+
+// NewAzurermKubernetesClusterMonitoring returns a new rule instance
+func NewAzurermKubernetesClusterMonitoring() *AzurermKubernetesClusterMonitoring {
+	return &AzurermKubernetesClusterMonitoring{
+		resourceType: "azurerm_kubernetes_cluster",
+	}
+}
+
+// Name returns the rule name
+func (r *AzurermKubernetesClusterMonitoring) Name() string {
+	return "azurerm_kubernetes_cluster_monitoring"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AzurermKubernetesClusterMonitoring) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AzurermKubernetesClusterMonitoring) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *AzurermKubernetesClusterMonitoring) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks that the oms_agent and microsoft_defender blocks are present
+func (r *AzurermKubernetesClusterMonitoring) Check(runner tflint.Runner) error {
+	schema := &hclext.BodySchema{}
+	for _, block := range kubernetesClusterMonitoringBlocks {
+		schema.Blocks = append(schema.Blocks, hclext.BlockSchema{
+			Type: block.Type,
+			Body: &hclext.BodySchema{
+				Attributes: []hclext.AttributeSchema{{Name: "log_analytics_workspace_id"}},
+			},
+		})
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, schema, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		for _, block := range kubernetesClusterMonitoringBlocks {
+			if len(resource.Body.Blocks.OfType(block.Type)) > 0 {
+				continue
+			}
+			if err := runner.EmitIssue(r, block.Message, resource.DefRange); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
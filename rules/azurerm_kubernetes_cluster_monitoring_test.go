@@ -0,0 +1,91 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermKubernetesClusterMonitoring(t *testing.T) {
+	rule := NewAzurermKubernetesClusterMonitoring()
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "both blocks missing",
+			Content: `
+resource "azurerm_kubernetes_cluster" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "oms_agent block is missing, Container insights should send the cluster logs to a Log Analytics workspace",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 48},
+					},
+				},
+				{
+					Rule:    rule,
+					Message: "microsoft_defender block is missing, Microsoft Defender for Containers should be enabled",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 48},
+					},
+				},
+			},
+		},
+		{
+			Name: "microsoft_defender missing",
+			Content: `
+resource "azurerm_kubernetes_cluster" "example" {
+  oms_agent {
+    log_analytics_workspace_id = "id"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "microsoft_defender block is missing, Microsoft Defender for Containers should be enabled",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 48},
+					},
+				},
+			},
+		},
+		{
+			Name: "both blocks present",
+			Content: `
+resource "azurerm_kubernetes_cluster" "example" {
+  oms_agent {
+    log_analytics_workspace_id = "id"
+  }
+
+  microsoft_defender {
+    log_analytics_workspace_id = "id"
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
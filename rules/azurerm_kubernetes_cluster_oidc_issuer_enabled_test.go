@@ -0,0 +1,93 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermKubernetesClusterOIDCIssuerEnabled(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_kubernetes_cluster_oidc_issuer_enabled")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "workload identity without OIDC issuer",
+			Content: `
+resource "azurerm_kubernetes_cluster" "example" {
+  workload_identity_enabled = true
+  oidc_issuer_enabled       = false
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "oidc_issuer_enabled should be true, workload identity requires the OIDC issuer",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 31},
+						End:      hcl.Pos{Line: 4, Column: 36},
+					},
+				},
+			},
+		},
+		{
+			Name: "workload identity with OIDC issuer attribute missing",
+			Content: `
+resource "azurerm_kubernetes_cluster" "example" {
+  workload_identity_enabled = true
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "oidc_issuer_enabled is not defined and should be true, workload identity requires the OIDC issuer",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 48},
+					},
+				},
+			},
+		},
+		{
+			Name: "workload identity with OIDC issuer",
+			Content: `
+resource "azurerm_kubernetes_cluster" "example" {
+  workload_identity_enabled = true
+  oidc_issuer_enabled       = true
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "workload identity disabled",
+			Content: `
+resource "azurerm_kubernetes_cluster" "example" {
+  workload_identity_enabled = false
+  oidc_issuer_enabled       = false
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "workload identity attribute missing",
+			Content: `
+resource "azurerm_kubernetes_cluster" "example" {
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -8,6 +8,8 @@ import (
 )
 
 func Test_AzurermLinuxWebAppHTTPSOnly(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_linux_web_app_https_only")
+
 	tests := []struct {
 		Name     string
 		Content  string
@@ -21,7 +23,7 @@ resource "azurerm_linux_web_app" "example" {
 }`,
 			Expected: helper.Issues{
 				{
-					Rule:    NewAzurermLinuxWebAppHTTPSOnly(),
+					Rule:    rule,
 					Message: "https_only should be true",
 					Range: hcl.Range{
 						Filename: "resource.tf",
@@ -38,7 +40,7 @@ resource "azurerm_linux_web_app" "example" {
 }`,
 			Expected: helper.Issues{
 				{
-					Rule:    NewAzurermLinuxWebAppHTTPSOnly(),
+					Rule:    rule,
 					Message: "https_only is not defined and should be true",
 					Range: hcl.Range{
 						Filename: "resource.tf",
@@ -58,8 +60,6 @@ resource "azurerm_linux_web_app" "example" {
 		},
 	}
 
-	rule := NewAzurermLinuxWebAppHTTPSOnly()
-
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
 			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
@@ -72,3 +72,38 @@ resource "azurerm_linux_web_app" "example" {
 		})
 	}
 }
+
+func Test_AzurermLinuxWebAppHTTPSOnlyFix(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_linux_web_app_https_only")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected string
+	}{
+		{
+			Name: "insert https_only",
+			Content: `
+resource "azurerm_linux_web_app" "example" {
+  name = "example"
+}`,
+			Expected: `
+resource "azurerm_linux_web_app" "example" {
+  name       = "example"
+  https_only = true
+}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertChanges(t, map[string]string{"resource.tf": test.Expected}, runner.Changes())
+		})
+	}
+}
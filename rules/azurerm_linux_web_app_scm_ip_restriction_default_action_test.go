@@ -8,27 +8,30 @@ import (
 )
 
 func Test_AzurermLinuxWebAppScmIPRestrictionDefaultAction(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_linux_web_app_scm_ip_restriction_default_action")
+
 	tests := []struct {
 		Name     string
 		Content  string
+		Config   string
 		Expected helper.Issues
 	}{
 		{
 			Name: "scm_ip_restriction_default_action allowed",
 			Content: `
 resource "azurerm_linux_web_app" "example" {
-	site_config {
-		scm_ip_restriction_default_action = "Allow"
-	}
+    site_config {
+        scm_ip_restriction_default_action = "Allow"
+    }
 }`,
 			Expected: helper.Issues{
 				{
-					Rule:    NewAzurermLinuxWebAppScmIPRestrictionDefaultAction(),
+					Rule:    rule,
 					Message: "scm_ip_restriction_default_action should be Deny",
 					Range: hcl.Range{
 						Filename: "resource.tf",
-						Start:    hcl.Pos{Line: 4, Column: 39},
-						End:      hcl.Pos{Line: 4, Column: 46},
+						Start:    hcl.Pos{Line: 4, Column: 45},
+						End:      hcl.Pos{Line: 4, Column: 52},
 					},
 				},
 			},
@@ -37,12 +40,12 @@ resource "azurerm_linux_web_app" "example" {
 			Name: "scm_ip_restriction_default_action attribute missing",
 			Content: `
 resource "azurerm_linux_web_app" "example" {
-	site_config {
-	}
+    site_config {
+    }
 }`,
 			Expected: helper.Issues{
 				{
-					Rule:    NewAzurermLinuxWebAppScmIPRestrictionDefaultAction(),
+					Rule:    rule,
 					Message: "scm_ip_restriction_default_action is not defined and should be Deny",
 					Range: hcl.Range{
 						Filename: "resource.tf",
@@ -59,7 +62,7 @@ resource "azurerm_linux_web_app" "example" {
 }`,
 			Expected: helper.Issues{
 				{
-					Rule:    NewAzurermLinuxWebAppScmIPRestrictionDefaultAction(),
+					Rule:    rule,
 					Message: "scm_ip_restriction_default_action is not defined and should be Deny",
 					Range: hcl.Range{
 						Filename: "resource.tf",
@@ -79,9 +82,69 @@ resource "azurerm_linux_web_app" "example" {
 }`,
 			Expected: helper.Issues{},
 		},
+		{
+			Name: "scm_ip_restriction_default_action with configured default_action",
+			Content: `
+resource "azurerm_linux_web_app" "example" {
+    site_config {
+        scm_ip_restriction_default_action = "Deny"
+    }
+}`,
+			Config: `
+rule "azurerm_linux_web_app_scm_ip_restriction_default_action" {
+    enabled        = true
+    default_action = "Allow"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "scm_ip_restriction_default_action should be Allow",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 45},
+						End:      hcl.Pos{Line: 4, Column: 51},
+					},
+				},
+			},
+		},
 	}
 
-	rule := NewAzurermLinuxWebAppScmIPRestrictionDefaultAction()
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content, ".tflint.hcl": test.Config})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
+
+func Test_AzurermLinuxWebAppScmIPRestrictionDefaultActionFix(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_linux_web_app_scm_ip_restriction_default_action")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected string
+	}{
+		{
+			Name: "insert site_config block with scm_ip_restriction_default_action",
+			Content: `
+resource "azurerm_linux_web_app" "example" {
+  name = "example"
+}`,
+			Expected: `
+resource "azurerm_linux_web_app" "example" {
+  name = "example"
+  site_config {
+    scm_ip_restriction_default_action = "Deny"
+  }
+}`,
+		},
+	}
 
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
@@ -91,7 +154,7 @@ resource "azurerm_linux_web_app" "example" {
 				t.Fatalf("Unexpected error occurred: %s", err)
 			}
 
-			helper.AssertIssues(t, test.Expected, runner.Issues)
+			helper.AssertChanges(t, map[string]string{"resource.tf": test.Expected}, runner.Changes())
 		})
 	}
 }
@@ -8,6 +8,8 @@ import (
 )
 
 func Test_AzurermLinuxWebAppSlotHTTPSOnly(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_linux_web_app_slot_https_only")
+
 	tests := []struct {
 		Name     string
 		Content  string
@@ -21,7 +23,7 @@ resource "azurerm_linux_web_app_slot" "example" {
 }`,
 			Expected: helper.Issues{
 				{
-					Rule:    NewAzurermLinuxWebAppSlotHTTPSOnly(),
+					Rule:    rule,
 					Message: "https_only should be true",
 					Range: hcl.Range{
 						Filename: "resource.tf",
@@ -38,7 +40,7 @@ resource "azurerm_linux_web_app_slot" "example" {
 }`,
 			Expected: helper.Issues{
 				{
-					Rule:    NewAzurermLinuxWebAppSlotHTTPSOnly(),
+					Rule:    rule,
 					Message: "https_only is not defined and should be true",
 					Range: hcl.Range{
 						Filename: "resource.tf",
@@ -58,8 +60,6 @@ resource "azurerm_linux_web_app_slot" "example" {
 		},
 	}
 
-	rule := NewAzurermLinuxWebAppSlotHTTPSOnly()
-
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
 			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
@@ -8,9 +8,12 @@ import (
 )
 
 func Test_AzurermLinuxWebAppSlotMinimumTLSVersion(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_linux_web_app_slot_minimum_tls_version")
+
 	tests := []struct {
 		Name     string
 		Content  string
+		Config   string
 		Expected helper.Issues
 	}{
 		{
@@ -23,18 +26,12 @@ resource "azurerm_linux_web_app_slot" "example" {
 }`,
 			Expected: helper.Issues{
 				{
-					Rule:    NewAzurermLinuxWebAppSlotMinimumTLSVersion(),
+					Rule:    rule,
 					Message: "minimum_tls_version is set to 1.0, should be 1.2 or 1.3",
 					Range: hcl.Range{
 						Filename: "resource.tf",
-						Start: hcl.Pos{
-							Line:   4,
-							Column: 31,
-						},
-						End: hcl.Pos{
-							Line:   4,
-							Column: 36,
-						},
+						Start:    hcl.Pos{Line: 4, Column: 31},
+						End:      hcl.Pos{Line: 4, Column: 36},
 					},
 				},
 			},
@@ -68,18 +65,12 @@ resource "azurerm_linux_web_app_slot" "example" {
 }`,
 			Expected: helper.Issues{
 				{
-					Rule:    NewAzurermLinuxWebAppSlotMinimumTLSVersion(),
+					Rule:    rule,
 					Message: "minimum_tls_version is missing in site_config, should be set to 1.2 or 1.3",
 					Range: hcl.Range{
 						Filename: "resource.tf",
-						Start: hcl.Pos{
-							Line:   3,
-							Column: 5,
-						},
-						End: hcl.Pos{
-							Line:   3,
-							Column: 16,
-						},
+						Start:    hcl.Pos{Line: 3, Column: 5},
+						End:      hcl.Pos{Line: 3, Column: 16},
 					},
 				},
 			},
@@ -91,25 +82,79 @@ resource "azurerm_linux_web_app_slot" "example" {
 }`,
 			Expected: helper.Issues{
 				{
-					Rule:    NewAzurermLinuxWebAppSlotMinimumTLSVersion(),
+					Rule:    rule,
 					Message: "site_config block is missing, minimum_tls_version should be set to 1.2 or 1.3",
 					Range: hcl.Range{
 						Filename: "resource.tf",
-						Start: hcl.Pos{
-							Line:   2,
-							Column: 1,
-						},
-						End: hcl.Pos{
-							Line:   2,
-							Column: 48,
-						},
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 48},
+					},
+				},
+			},
+		},
+		{
+			Name: "minimum_tls_version not in configured allowed_versions",
+			Content: `
+resource "azurerm_linux_web_app_slot" "example" {
+    site_config {
+        minimum_tls_version = "1.2"
+    }
+}`,
+			Config: `
+rule "azurerm_linux_web_app_slot_minimum_tls_version" {
+    enabled          = true
+    allowed_versions = ["1.3"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "minimum_tls_version is set to 1.2, should be 1.3",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 31},
+						End:      hcl.Pos{Line: 4, Column: 36},
 					},
 				},
 			},
 		},
 	}
 
-	rule := NewAzurermLinuxWebAppSlotMinimumTLSVersion()
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content, ".tflint.hcl": test.Config})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
+
+func Test_AzurermLinuxWebAppSlotMinimumTLSVersionFix(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_linux_web_app_slot_minimum_tls_version")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected string
+	}{
+		{
+			Name: "insert site_config block",
+			Content: `
+resource "azurerm_linux_web_app_slot" "example" {
+  name = "example"
+}`,
+			Expected: `
+resource "azurerm_linux_web_app_slot" "example" {
+  name = "example"
+  site_config {
+    minimum_tls_version = "1.2"
+  }
+}`,
+		},
+	}
 
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
@@ -119,7 +164,7 @@ resource "azurerm_linux_web_app_slot" "example" {
 				t.Fatalf("Unexpected error occurred: %s", err)
 			}
 
-			helper.AssertIssues(t, test.Expected, runner.Issues)
+			helper.AssertChanges(t, map[string]string{"resource.tf": test.Expected}, runner.Changes())
 		})
 	}
 }
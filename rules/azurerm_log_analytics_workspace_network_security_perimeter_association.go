@@ -0,0 +1,46 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+)
+
+// AzurermLogAnalyticsWorkspaceNetworkSecurityPerimeterAssociation checks that Log Analytics workspaces have an NSP association
+type AzurermLogAnalyticsWorkspaceNetworkSecurityPerimeterAssociation struct {
+	tflint.DefaultRule
+
+	resourceType string
+}
+
+// NewAzurermLogAnalyticsWorkspaceNetworkSecurityPerimeterAssociation returns a new rule instance
+func NewAzurermLogAnalyticsWorkspaceNetworkSecurityPerimeterAssociation() *AzurermLogAnalyticsWorkspaceNetworkSecurityPerimeterAssociation {
+	return &AzurermLogAnalyticsWorkspaceNetworkSecurityPerimeterAssociation{
+		resourceType: "azurerm_log_analytics_workspace",
+	}
+}
+
+// Name returns the rule name
+func (r *AzurermLogAnalyticsWorkspaceNetworkSecurityPerimeterAssociation) Name() string {
+	return "azurerm_log_analytics_workspace_network_security_perimeter_association"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AzurermLogAnalyticsWorkspaceNetworkSecurityPerimeterAssociation) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AzurermLogAnalyticsWorkspaceNetworkSecurityPerimeterAssociation) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AzurermLogAnalyticsWorkspaceNetworkSecurityPerimeterAssociation) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks if Log Analytics workspaces have an associated network security perimeter
+func (r *AzurermLogAnalyticsWorkspaceNetworkSecurityPerimeterAssociation) Check(runner tflint.Runner) error {
+	return checkNetworkSecurityPerimeterAssociation(runner, r, r.resourceType, "Log Analytics Workspace")
+}
@@ -0,0 +1,96 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermLogAnalyticsWorkspaceNetworkSecurityPerimeterAssociation(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "without NSP association",
+			Content: `
+resource "azurerm_log_analytics_workspace" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAzurermLogAnalyticsWorkspaceNetworkSecurityPerimeterAssociation(),
+					Message: "Log Analytics Workspace 'example' does not have an associated azurerm_network_security_perimeter_association",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 53},
+					},
+				},
+			},
+		},
+		{
+			Name: "with NSP association",
+			Content: `
+resource "azurerm_log_analytics_workspace" "example" {
+}
+
+resource "azurerm_network_security_perimeter_association" "example" {
+  resource_id = azurerm_log_analytics_workspace.example.id
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "with NSP association through a local value",
+			Content: `
+resource "azurerm_log_analytics_workspace" "example" {
+  count = 1
+}
+
+locals {
+  log_analytics_workspace_id = one(azurerm_log_analytics_workspace.example[*].id)
+}
+
+resource "azurerm_network_security_perimeter_association" "example" {
+  resource_id = local.log_analytics_workspace_id
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "NSP association of another resource",
+			Content: `
+resource "azurerm_log_analytics_workspace" "example" {
+}
+
+resource "azurerm_network_security_perimeter_association" "example" {
+  resource_id = azurerm_log_analytics_workspace.example.name
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAzurermLogAnalyticsWorkspaceNetworkSecurityPerimeterAssociation(),
+					Message: "Log Analytics Workspace 'example' does not have an associated azurerm_network_security_perimeter_association",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 53},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewAzurermLogAnalyticsWorkspaceNetworkSecurityPerimeterAssociation()
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
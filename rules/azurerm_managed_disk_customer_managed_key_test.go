@@ -0,0 +1,82 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermManagedDiskCustomerManagedKey(t *testing.T) {
+	rule := findRule[*CustomerManagedKey](t, NewCustomerManagedKeyRules(), "azurerm_managed_disk_customer_managed_key")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "Managed disk with disk encryption set",
+			Content: `
+resource "azurerm_managed_disk" "example" {
+  disk_encryption_set_id = azurerm_disk_encryption_set.example.id
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Managed disk with disk encryption set using a key without rotation policy",
+			Content: `
+resource "azurerm_managed_disk" "example" {
+  disk_encryption_set_id = azurerm_disk_encryption_set.example.id
+}
+
+resource "azurerm_disk_encryption_set" "example" {
+  key_vault_key_id = azurerm_key_vault_key.example.id
+}
+
+resource "azurerm_key_vault_key" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "customer-managed key azurerm_key_vault_key.example is not rotated automatically, rotation_policy block is missing, should be defined with expire_after property",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 28},
+						End:      hcl.Pos{Line: 3, Column: 66},
+					},
+				},
+			},
+		},
+		{
+			Name: "Managed disk with disk encryption set using a key with rotation policy",
+			Content: `
+resource "azurerm_managed_disk" "example" {
+  disk_encryption_set_id = azurerm_disk_encryption_set.example.id
+}
+
+resource "azurerm_disk_encryption_set" "example" {
+  key_vault_key_id = azurerm_key_vault_key.example.versionless_id
+}
+
+resource "azurerm_key_vault_key" "example" {
+  rotation_policy {
+    expire_after = "P90D"
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermMssqlDatabaseLedgerEnabled(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_mssql_database_ledger_enabled")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "ledger disabled",
+			Content: `
+resource "azurerm_mssql_database" "example" {
+  ledger_enabled = false
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "ledger_enabled should be true to make the database tamper-evident",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 20},
+						End:      hcl.Pos{Line: 3, Column: 25},
+					},
+				},
+			},
+		},
+		{
+			Name: "ledger enabled",
+			Content: `
+resource "azurerm_mssql_database" "example" {
+  ledger_enabled = true
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
+
+func Test_AzurermMssqlDatabaseLedgerEnabledFix(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_mssql_database_ledger_enabled")
+
+	tests := []struct {
+		Name    string
+		Content string
+	}{
+		{
+			Name: "ledger disabled",
+			Content: `
+resource "azurerm_mssql_database" "example" {
+  ledger_enabled = false
+}`,
+		},
+		{
+			Name: "ledger_enabled attribute missing",
+			Content: `
+resource "azurerm_mssql_database" "example" {
+}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertChanges(t, map[string]string{}, runner.Changes())
+		})
+	}
+}
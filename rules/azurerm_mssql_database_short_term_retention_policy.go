@@ -0,0 +1,106 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+)
+
+// AzurermMssqlDatabaseShortTermRetentionPolicy checks that SQL databases keep point-in-time restore backups long enough
+type AzurermMssqlDatabaseShortTermRetentionPolicy struct {
+	tflint.DefaultRule
+
+	resourceType string
+}
+
+// NewAzurermMssqlDatabaseShortTermRetentionPolicy returns a new rule instance
+func NewAzurermMssqlDatabaseShortTermRetentionPolicy() *AzurermMssqlDatabaseShortTermRetentionPolicy {
+	return &AzurermMssqlDatabaseShortTermRetentionPolicy{
+		resourceType: "azurerm_mssql_database",
+	}
+}
+
+// Name returns the rule name
+func (r *AzurermMssqlDatabaseShortTermRetentionPolicy) Name() string {
+	return "azurerm_mssql_database_short_term_retention_policy"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AzurermMssqlDatabaseShortTermRetentionPolicy) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AzurermMssqlDatabaseShortTermRetentionPolicy) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *AzurermMssqlDatabaseShortTermRetentionPolicy) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks that the short_term_retention_policy block keeps backups for at least the configured minimum.
+// Databases without the block keep the Azure default retention, which is only reported when below the minimum.
+func (r *AzurermMssqlDatabaseShortTermRetentionPolicy) Check(runner tflint.Runner) error {
+	minimum, err := decodeRetentionRuleConfig(runner, r.Name(), DefaultShortTermRetentionDays)
+	if err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "short_term_retention_policy",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "retention_days"}},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		policies := resource.Body.Blocks.OfType("short_term_retention_policy")
+		if len(policies) == 0 {
+			if DefaultShortTermRetentionDays >= minimum {
+				continue
+			}
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("short_term_retention_policy block is missing and defaults to %d days, point-in-time restore backups should be kept for at least %d days", DefaultShortTermRetentionDays, minimum),
+				resource.DefRange,
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		attribute, exists := policies[0].Body.Attributes["retention_days"]
+		if !exists {
+			continue
+		}
+		err := runner.EvaluateExpr(attribute.Expr, func(days int) error {
+			if days >= minimum {
+				return nil
+			}
+			return runner.EmitIssueWithFix(
+				r,
+				fmt.Sprintf("retention_days is %d, point-in-time restore backups should be kept for at least %d days", days, minimum),
+				attribute.Expr.Range(),
+				fixReplaceLiteral(attribute, cty.NumberIntVal(int64(minimum))),
+			)
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
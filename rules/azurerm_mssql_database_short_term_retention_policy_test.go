@@ -0,0 +1,133 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermMssqlDatabaseShortTermRetentionPolicy(t *testing.T) {
+	rule := NewAzurermMssqlDatabaseShortTermRetentionPolicy()
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "short-term retention policy missing with the default retention",
+			Content: `
+resource "azurerm_mssql_database" "example" {
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "short-term retention policy missing below the configured minimum",
+			Content: `
+resource "azurerm_mssql_database" "example" {
+}`,
+			Config: `
+rule "azurerm_mssql_database_short_term_retention_policy" {
+  enabled                = true
+  minimum_retention_days = 14
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "short_term_retention_policy block is missing and defaults to 7 days, point-in-time restore backups should be kept for at least 14 days",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 44},
+					},
+				},
+			},
+		},
+		{
+			Name: "short-term retention too short",
+			Content: `
+resource "azurerm_mssql_database" "example" {
+  short_term_retention_policy {
+    retention_days = 3
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "retention_days is 3, point-in-time restore backups should be kept for at least 7 days",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 22},
+						End:      hcl.Pos{Line: 4, Column: 23},
+					},
+				},
+			},
+		},
+		{
+			Name: "short-term retention below the configured minimum",
+			Content: `
+resource "azurerm_mssql_database" "example" {
+  short_term_retention_policy {
+    retention_days = 7
+  }
+}`,
+			Config: `
+rule "azurerm_mssql_database_short_term_retention_policy" {
+  enabled                = true
+  minimum_retention_days = 14
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "retention_days is 7, point-in-time restore backups should be kept for at least 14 days",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 22},
+						End:      hcl.Pos{Line: 4, Column: 23},
+					},
+				},
+			},
+		},
+		{
+			Name: "short-term retention long enough",
+			Content: `
+resource "azurerm_mssql_database" "example" {
+  short_term_retention_policy {
+    retention_days = 35
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content, ".tflint.hcl": test.Config})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
+
+func Test_AzurermMssqlDatabaseShortTermRetentionPolicyConfig(t *testing.T) {
+	runner := helper.TestRunner(t, map[string]string{
+		"resource.tf": `
+resource "azurerm_mssql_database" "example" {
+}`,
+		".tflint.hcl": `
+rule "azurerm_mssql_database_short_term_retention_policy" {
+  enabled                = true
+  minimum_retention_days = 0
+}`,
+	})
+
+	if err := NewAzurermMssqlDatabaseShortTermRetentionPolicy().Check(runner); err == nil {
+		t.Fatal("Expected an error for minimum_retention_days = 0")
+	}
+}
@@ -72,3 +72,53 @@ resource "azurerm_mssql_database" "example" {
 		})
 	}
 }
+
+func Test_AzurermMssqlDatabaseEncryptionFix(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected string
+	}{
+		{
+			Name: "rewrite transparent_data_encryption_enabled",
+			Content: `
+resource "azurerm_mssql_database" "example" {
+  transparent_data_encryption_enabled = false
+}`,
+			Expected: `
+resource "azurerm_mssql_database" "example" {
+  transparent_data_encryption_enabled = true
+}`,
+		},
+		{
+			Name: "insert transparent_data_encryption_enabled",
+			Content: `
+resource "azurerm_mssql_database" "example" {
+  name = "example"
+}`,
+			Expected: `
+resource "azurerm_mssql_database" "example" {
+  name                                = "example"
+  transparent_data_encryption_enabled = true
+}`,
+		},
+	}
+
+	rule := NewAzurermMssqlDatabaseEncryption()
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			expected := map[string]string{}
+			if test.Expected != "" {
+				expected["resource.tf"] = test.Expected
+			}
+			helper.AssertChanges(t, expected, runner.Changes())
+		})
+	}
+}
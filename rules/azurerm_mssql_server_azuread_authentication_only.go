@@ -6,6 +6,7 @@ import (
 
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
 	
 	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
 )
@@ -80,21 +81,27 @@ func (r *AzurermMsSQLServerAdAuthOnly) Check(runner tflint.Runner) error {
 		siteConfig := siteConfigBlocks[0]
 		attribute, exists := siteConfig.Body.Attributes["azuread_authentication_only"]
 		if !exists {
-			runner.EmitIssue(
+			if err := runner.EmitIssueWithFix(
 				r,
 				"azuread_authentication_only is missing in azuread_administrator, should be set to true",
 				siteConfig.DefRange,
-			)
+				fixInsertAttribute(runner, siteConfig, "azuread_authentication_only", cty.True),
+			); err != nil {
+				return err
+			}
 			continue
 		}
 
 		err := runner.EvaluateExpr(attribute.Expr, func(val string) error {
 			if !strings.EqualFold(val, r.expectedValue) {
-				runner.EmitIssue(
+				if err := runner.EmitIssueWithFix(
 					r,
 					fmt.Sprintf("azuread_authentication_only is set to %s, should be set to true", val),
 					attribute.Expr.Range(),
-				)
+					fixReplaceLiteral(attribute, cty.True),
+				); err != nil {
+					return err
+				}
 			}
 			return nil
 		}, nil)
@@ -112,4 +112,53 @@ resource "azurerm_mssql_server" "example" {
 			helper.AssertIssues(t, test.Expected, runner.Issues)
 		})
 	}
-}
\ No newline at end of file
+}
+
+func Test_AzurermMsSQLServerAdAuthOnlyFix(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected string
+	}{
+		{
+			Name: "rewrite azuread_authentication_only",
+			Content: `
+resource "azurerm_mssql_server" "example" {
+  azuread_administrator {
+    azuread_authentication_only = false
+  }
+}`,
+			Expected: `
+resource "azurerm_mssql_server" "example" {
+  azuread_administrator {
+    azuread_authentication_only = true
+  }
+}`,
+		},
+		{
+			Name: "azuread_administrator block is not inserted",
+			Content: `
+resource "azurerm_mssql_server" "example" {
+}`,
+			Expected: ``,
+		},
+	}
+
+	rule := NewAzurermMsSQLServerAdAuthOnly()
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			expected := map[string]string{}
+			if test.Expected != "" {
+				expected["resource.tf"] = test.Expected
+			}
+			helper.AssertChanges(t, expected, runner.Changes())
+		})
+	}
+}
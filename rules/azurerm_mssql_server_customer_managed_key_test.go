@@ -0,0 +1,94 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermMssqlServerCustomerManagedKey(t *testing.T) {
+	rule := findRule[*CustomerManagedKey](t, NewCustomerManagedKeyRules(), "azurerm_mssql_server_customer_managed_key")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "SQL server without transparent data encryption",
+			Content: `
+resource "azurerm_mssql_server" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "SQL Server is not encrypted with a customer-managed key, an azurerm_mssql_server_transparent_data_encryption should set key_vault_key_id",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 42},
+					},
+				},
+			},
+		},
+		{
+			Name: "SQL server with a service-managed key",
+			Content: `
+resource "azurerm_mssql_server" "example" {
+}
+
+resource "azurerm_mssql_server_transparent_data_encryption" "example" {
+  server_id = azurerm_mssql_server.example.id
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "key_vault_key_id is not defined, the SQL Server is encrypted with a service-managed key",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 1},
+						End:      hcl.Pos{Line: 5, Column: 70},
+					},
+				},
+			},
+		},
+		{
+			Name: "SQL server with a key without rotation policy",
+			Content: `
+resource "azurerm_mssql_server" "example" {
+}
+
+resource "azurerm_mssql_server_transparent_data_encryption" "example" {
+  server_id        = azurerm_mssql_server.example.id
+  key_vault_key_id = azurerm_key_vault_key.example.id
+}
+
+resource "azurerm_key_vault_key" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "customer-managed key azurerm_key_vault_key.example is not rotated automatically, rotation_policy block is missing, should be defined with expire_after property",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 22},
+						End:      hcl.Pos{Line: 7, Column: 54},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
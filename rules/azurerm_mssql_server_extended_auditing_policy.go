@@ -0,0 +1,136 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+)
+
+// mssqlServerExtendedAuditingPolicy enables auditing on SQL Servers
+var mssqlServerExtendedAuditingPolicy = association{
+	ResourceType: "azurerm_mssql_server_extended_auditing_policy",
+	Path:         []string{"server_id"},
+	Attributes:   []string{"enabled", "retention_in_days"},
+}
+
+// AzurermMssqlServerExtendedAuditingPolicy checks that SQL Servers are audited, with a minimum retention
+type AzurermMssqlServerExtendedAuditingPolicy struct {
+	tflint.DefaultRule
+
+	resourceType string
+}
+
+// NewAzurermMssqlServerExtendedAuditingPolicy returns a new rule instance
+func NewAzurermMssqlServerExtendedAuditingPolicy() *AzurermMssqlServerExtendedAuditingPolicy {
+	return &AzurermMssqlServerExtendedAuditingPolicy{
+		resourceType: "azurerm_mssql_server",
+	}
+}
+
+// Name returns the rule name
+func (r *AzurermMssqlServerExtendedAuditingPolicy) Name() string {
+	return "azurerm_mssql_server_extended_auditing_policy"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AzurermMssqlServerExtendedAuditingPolicy) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AzurermMssqlServerExtendedAuditingPolicy) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AzurermMssqlServerExtendedAuditingPolicy) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks that SQL Servers have an enabled azurerm_mssql_server_extended_auditing_policy
+// whose retention_in_days is unlimited (0) or at least the configured minimum
+func (r *AzurermMssqlServerExtendedAuditingPolicy) Check(runner tflint.Runner) error {
+	minimum, err := decodeRetentionRuleConfig(runner, r.Name(), DefaultAuditRetentionDays)
+	if err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	policies, err := newAssociationIndex(runner, mssqlServerExtendedAuditingPolicy, r.resourceType)
+	if err != nil {
+		return err
+	}
+
+	checked := map[*hclext.Block]bool{}
+	for _, resource := range resources.Blocks {
+		if len(resource.Labels) < 2 {
+			continue
+		}
+		name := resource.Labels[1]
+
+		if len(policies[name]) == 0 {
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("SQL Server '%s' does not have an associated azurerm_mssql_server_extended_auditing_policy", name),
+				resource.DefRange,
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		for _, policy := range policies[name] {
+			if checked[policy] {
+				continue
+			}
+			checked[policy] = true
+			if err := r.checkPolicy(runner, policy, minimum); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkPolicy checks that the auditing policy is enabled and keeps the audit logs long enough
+func (r *AzurermMssqlServerExtendedAuditingPolicy) checkPolicy(runner tflint.Runner, policy *hclext.Block, minimum int) error {
+	if attribute, exists := policy.Body.Attributes["enabled"]; exists {
+		value, known, err := evaluateValue(runner, attribute.Expr)
+		if err != nil {
+			return err
+		}
+		if known && value.Type() == cty.Bool && value.False() {
+			return runner.EmitIssue(r, "enabled should be true, the auditing policy is disabled", attribute.Expr.Range())
+		}
+	}
+
+	attribute, exists := policy.Body.Attributes["retention_in_days"]
+	if !exists {
+		// retention_in_days defaults to 0, which keeps the audit logs indefinitely
+		return nil
+	}
+	value, known, err := evaluateValue(runner, attribute.Expr)
+	if err != nil {
+		return err
+	}
+	if !known || value.Type() != cty.Number {
+		return nil
+	}
+	if value.GreaterThan(cty.Zero).True() && value.LessThan(cty.NumberIntVal(int64(minimum))).True() {
+		return runner.EmitIssue(
+			r,
+			fmt.Sprintf("retention_in_days is %s, audit logs should be kept for at least %d days or indefinitely (0)", value.AsBigFloat().Text('f', -1), minimum),
+			attribute.Expr.Range(),
+		)
+	}
+	return nil
+}
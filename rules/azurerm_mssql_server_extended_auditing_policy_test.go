@@ -0,0 +1,179 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermMssqlServerExtendedAuditingPolicy(t *testing.T) {
+	rule := NewAzurermMssqlServerExtendedAuditingPolicy()
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "auditing policy missing",
+			Content: `
+resource "azurerm_mssql_server" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "SQL Server 'example' does not have an associated azurerm_mssql_server_extended_auditing_policy",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 42},
+					},
+				},
+			},
+		},
+		{
+			Name: "auditing policy with unlimited retention",
+			Content: `
+resource "azurerm_mssql_server" "example" {
+}
+
+resource "azurerm_mssql_server_extended_auditing_policy" "example" {
+  server_id = azurerm_mssql_server.example.id
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "auditing policy disabled",
+			Content: `
+resource "azurerm_mssql_server" "example" {
+}
+
+resource "azurerm_mssql_server_extended_auditing_policy" "example" {
+  server_id         = azurerm_mssql_server.example.id
+  enabled           = false
+  retention_in_days = 90
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "enabled should be true, the auditing policy is disabled",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 23},
+						End:      hcl.Pos{Line: 7, Column: 28},
+					},
+				},
+			},
+		},
+		{
+			Name: "auditing retention too short",
+			Content: `
+resource "azurerm_mssql_server" "example" {
+}
+
+resource "azurerm_mssql_server_extended_auditing_policy" "example" {
+  server_id         = azurerm_mssql_server.example.id
+  retention_in_days = 30
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "retention_in_days is 30, audit logs should be kept for at least 90 days or indefinitely (0)",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 23},
+						End:      hcl.Pos{Line: 7, Column: 25},
+					},
+				},
+			},
+		},
+		{
+			Name: "auditing retention of the configured minimum",
+			Content: `
+resource "azurerm_mssql_server" "example" {
+}
+
+resource "azurerm_mssql_server_extended_auditing_policy" "example" {
+  server_id         = azurerm_mssql_server.example.id
+  retention_in_days = 30
+}`,
+			Config: `
+rule "azurerm_mssql_server_extended_auditing_policy" {
+  enabled                = true
+  minimum_retention_days = 30
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "auditing retention from a sensitive variable",
+			Content: `
+resource "azurerm_mssql_server" "example" {
+}
+
+resource "azurerm_mssql_server_extended_auditing_policy" "example" {
+  server_id         = azurerm_mssql_server.example.id
+  enabled           = var.auditing_enabled
+  retention_in_days = var.retention_in_days
+}
+
+variable "auditing_enabled" {
+  default   = true
+  sensitive = true
+}
+
+variable "retention_in_days" {
+  default   = 30
+  sensitive = true
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "retention_in_days is 30, audit logs should be kept for at least 90 days or indefinitely (0)",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 8, Column: 23},
+						End:      hcl.Pos{Line: 8, Column: 44},
+					},
+				},
+			},
+		},
+		{
+			Name: "auditing policies of servers created with for_each",
+			Content: `
+resource "azurerm_mssql_server" "example" {
+  for_each = toset(["a", "b"])
+}
+
+resource "azurerm_mssql_server_extended_auditing_policy" "example" {
+  for_each          = azurerm_mssql_server.example
+  server_id         = each.value.id
+  retention_in_days = 10
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "retention_in_days is 10, audit logs should be kept for at least 90 days or indefinitely (0)",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 9, Column: 23},
+						End:      hcl.Pos{Line: 9, Column: 25},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content, ".tflint.hcl": test.Config})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+)
+
+// AzurermMssqlServerNetworkSecurityPerimeterAssociation checks that SQL servers have an NSP association
+type AzurermMssqlServerNetworkSecurityPerimeterAssociation struct {
+	tflint.DefaultRule
+
+	resourceType string
+}
+
+// NewAzurermMssqlServerNetworkSecurityPerimeterAssociation returns a new rule instance
+func NewAzurermMssqlServerNetworkSecurityPerimeterAssociation() *AzurermMssqlServerNetworkSecurityPerimeterAssociation {
+	return &AzurermMssqlServerNetworkSecurityPerimeterAssociation{
+		resourceType: "azurerm_mssql_server",
+	}
+}
+
+// Name returns the rule name
+func (r *AzurermMssqlServerNetworkSecurityPerimeterAssociation) Name() string {
+	return "azurerm_mssql_server_network_security_perimeter_association"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AzurermMssqlServerNetworkSecurityPerimeterAssociation) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AzurermMssqlServerNetworkSecurityPerimeterAssociation) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AzurermMssqlServerNetworkSecurityPerimeterAssociation) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks if SQL servers have an associated network security perimeter
+func (r *AzurermMssqlServerNetworkSecurityPerimeterAssociation) Check(runner tflint.Runner) error {
+	return checkNetworkSecurityPerimeterAssociation(runner, r, r.resourceType, "SQL Server")
+}
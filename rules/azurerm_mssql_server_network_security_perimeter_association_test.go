@@ -0,0 +1,96 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermMssqlServerNetworkSecurityPerimeterAssociation(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "without NSP association",
+			Content: `
+resource "azurerm_mssql_server" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAzurermMssqlServerNetworkSecurityPerimeterAssociation(),
+					Message: "SQL Server 'example' does not have an associated azurerm_network_security_perimeter_association",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 42},
+					},
+				},
+			},
+		},
+		{
+			Name: "with NSP association",
+			Content: `
+resource "azurerm_mssql_server" "example" {
+}
+
+resource "azurerm_network_security_perimeter_association" "example" {
+  resource_id = azurerm_mssql_server.example.id
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "with NSP association through a local value",
+			Content: `
+resource "azurerm_mssql_server" "example" {
+  count = 1
+}
+
+locals {
+  mssql_server_id = one(azurerm_mssql_server.example[*].id)
+}
+
+resource "azurerm_network_security_perimeter_association" "example" {
+  resource_id = local.mssql_server_id
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "NSP association of another resource",
+			Content: `
+resource "azurerm_mssql_server" "example" {
+}
+
+resource "azurerm_network_security_perimeter_association" "example" {
+  resource_id = azurerm_mssql_server.example.name
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAzurermMssqlServerNetworkSecurityPerimeterAssociation(),
+					Message: "SQL Server 'example' does not have an associated azurerm_network_security_perimeter_association",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 42},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewAzurermMssqlServerNetworkSecurityPerimeterAssociation()
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package rules
 import (
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
 	
 	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
 )
@@ -58,21 +59,27 @@ func (r *AzurermMsSQLServerPublicNetworkAccessEnabled) Check(runner tflint.Runne
 		attribute, exists := resource.Body.Attributes[r.attributeName]
 		if !exists {
 			// Emit an issue if the attribute does not exist
-			runner.EmitIssue(
+			if err := runner.EmitIssueWithFix(
 				r,
 				"public_network_access_enabled is not defined and defaults to true, consider disabling it",
 				resource.DefRange,
-			)
+				fixInsertAttribute(runner, resource, r.attributeName, cty.False),
+			); err != nil {
+				return err
+			}
 			continue
 		}
 
 		err := runner.EvaluateExpr(attribute.Expr, func(val bool) error {
 			if val {
-				runner.EmitIssue(
+				if err := runner.EmitIssueWithFix(
 					r,
 					"Consider changing public_network_access_enabled to false",
 					attribute.Expr.Range(),
-				)
+					fixReplaceLiteral(attribute, cty.False),
+				); err != nil {
+					return err
+				}
 			}
 			return nil
 		}, nil)
@@ -0,0 +1,131 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+)
+
+// mssqlServerSecurityAlertPolicy enables threat detection on SQL Servers, which it identifies by name
+var mssqlServerSecurityAlertPolicy = association{
+	ResourceType:       "azurerm_mssql_server_security_alert_policy",
+	Path:               []string{"server_name"},
+	Attributes:         []string{"state", "disabled_alerts"},
+	ReferenceAttribute: "name",
+}
+
+// AzurermMssqlServerSecurityAlertPolicy checks that threat detection is enabled for all alerts on SQL Servers
+type AzurermMssqlServerSecurityAlertPolicy struct {
+	tflint.DefaultRule
+
+	resourceType string
+}
+
+// NewAzurermMssqlServerSecurityAlertPolicy returns a new rule instance
+func NewAzurermMssqlServerSecurityAlertPolicy() *AzurermMssqlServerSecurityAlertPolicy {
+	return &AzurermMssqlServerSecurityAlertPolicy{
+		resourceType: "azurerm_mssql_server",
+	}
+}
+
+// Name returns the rule name
+func (r *AzurermMssqlServerSecurityAlertPolicy) Name() string {
+	return "azurerm_mssql_server_security_alert_policy"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AzurermMssqlServerSecurityAlertPolicy) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AzurermMssqlServerSecurityAlertPolicy) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AzurermMssqlServerSecurityAlertPolicy) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks that SQL Servers have an azurerm_mssql_server_security_alert_policy with state Enabled and no disabled_alerts
+func (r *AzurermMssqlServerSecurityAlertPolicy) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	policies, err := newAssociationIndex(runner, mssqlServerSecurityAlertPolicy, r.resourceType)
+	if err != nil {
+		return err
+	}
+
+	checked := map[*hclext.Block]bool{}
+	for _, resource := range resources.Blocks {
+		if len(resource.Labels) < 2 {
+			continue
+		}
+		name := resource.Labels[1]
+
+		if len(policies[name]) == 0 {
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("SQL Server '%s' does not have an associated azurerm_mssql_server_security_alert_policy", name),
+				resource.DefRange,
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		for _, policy := range policies[name] {
+			if checked[policy] {
+				continue
+			}
+			checked[policy] = true
+			if err := r.checkPolicy(runner, policy); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkPolicy checks that the alert policy is enabled and does not disable any alert
+func (r *AzurermMssqlServerSecurityAlertPolicy) checkPolicy(runner tflint.Runner, policy *hclext.Block) error {
+	state, exists := policy.Body.Attributes["state"]
+	if !exists {
+		return runner.EmitIssue(r, "state is not defined and should be Enabled", policy.DefRange)
+	}
+	values, known, err := evaluateStrings(runner, state.Expr)
+	if err != nil {
+		return err
+	}
+	if known && len(values) == 1 && values[0] != "Enabled" {
+		if err := runner.EmitIssue(r, fmt.Sprintf("state is %s, it should be Enabled", values[0]), state.Expr.Range()); err != nil {
+			return err
+		}
+	}
+
+	disabledAlerts, exists := policy.Body.Attributes["disabled_alerts"]
+	if !exists {
+		return nil
+	}
+	alerts, known, err := evaluateStrings(runner, disabledAlerts.Expr)
+	if err != nil {
+		return err
+	}
+	if known && len(alerts) > 0 {
+		return runner.EmitIssue(
+			r,
+			fmt.Sprintf("disabled_alerts disables %s, all alerts should be enabled", strings.Join(alerts, ", ")),
+			disabledAlerts.Expr.Range(),
+		)
+	}
+	return nil
+}
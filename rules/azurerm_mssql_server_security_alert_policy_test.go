@@ -0,0 +1,118 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermMssqlServerSecurityAlertPolicy(t *testing.T) {
+	rule := NewAzurermMssqlServerSecurityAlertPolicy()
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "security alert policy missing",
+			Content: `
+resource "azurerm_mssql_server" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "SQL Server 'example' does not have an associated azurerm_mssql_server_security_alert_policy",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 42},
+					},
+				},
+			},
+		},
+		{
+			Name: "security alert policy enabled",
+			Content: `
+resource "azurerm_mssql_server" "example" {
+}
+
+resource "azurerm_mssql_server_security_alert_policy" "example" {
+  resource_group_name = "example"
+  server_name         = azurerm_mssql_server.example.name
+  state               = "Enabled"
+  disabled_alerts     = []
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "security alert policy disabled with disabled alerts",
+			Content: `
+resource "azurerm_mssql_server" "example" {
+}
+
+resource "azurerm_mssql_server_security_alert_policy" "example" {
+  resource_group_name = "example"
+  server_name         = azurerm_mssql_server.example.name
+  state               = "Disabled"
+  disabled_alerts     = ["Sql_Injection", "Data_Exfiltration"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "state is Disabled, it should be Enabled",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 8, Column: 25},
+						End:      hcl.Pos{Line: 8, Column: 35},
+					},
+				},
+				{
+					Rule:    rule,
+					Message: "disabled_alerts disables Sql_Injection, Data_Exfiltration, all alerts should be enabled",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 9, Column: 25},
+						End:      hcl.Pos{Line: 9, Column: 63},
+					},
+				},
+			},
+		},
+		{
+			Name: "security alert policy referencing the server id",
+			Content: `
+resource "azurerm_mssql_server" "example" {
+}
+
+resource "azurerm_mssql_server_security_alert_policy" "example" {
+  resource_group_name = "example"
+  server_name         = azurerm_mssql_server.example.id
+  state               = "Enabled"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "SQL Server 'example' does not have an associated azurerm_mssql_server_security_alert_policy",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 42},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+)
+
+// mssqlServerVulnerabilityAssessment enables vulnerability assessment on the SQL Server of a security alert policy
+var mssqlServerVulnerabilityAssessment = association{
+	ResourceType: "azurerm_mssql_server_vulnerability_assessment",
+	Path:         []string{"server_security_alert_policy_id"},
+}
+
+// AzurermMssqlServerVulnerabilityAssessment checks that vulnerability assessment is enabled on SQL Servers
+type AzurermMssqlServerVulnerabilityAssessment struct {
+	tflint.DefaultRule
+
+	resourceType string
+}
+
+// NewAzurermMssqlServerVulnerabilityAssessment returns a new rule instance
+func NewAzurermMssqlServerVulnerabilityAssessment() *AzurermMssqlServerVulnerabilityAssessment {
+	return &AzurermMssqlServerVulnerabilityAssessment{
+		resourceType: "azurerm_mssql_server",
+	}
+}
+
+// Name returns the rule name
+func (r *AzurermMssqlServerVulnerabilityAssessment) Name() string {
+	return "azurerm_mssql_server_vulnerability_assessment"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AzurermMssqlServerVulnerabilityAssessment) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AzurermMssqlServerVulnerabilityAssessment) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AzurermMssqlServerVulnerabilityAssessment) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks that SQL Servers have a security alert policy that is the server_security_alert_policy_id
+// of an azurerm_mssql_server_vulnerability_assessment
+func (r *AzurermMssqlServerVulnerabilityAssessment) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	policies, err := newAssociationIndex(runner, mssqlServerSecurityAlertPolicy, r.resourceType)
+	if err != nil {
+		return err
+	}
+	assessments, err := newAssociationIndex(runner, mssqlServerVulnerabilityAssessment, mssqlServerSecurityAlertPolicy.ResourceType)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		if len(resource.Labels) < 2 {
+			continue
+		}
+		name := resource.Labels[1]
+
+		assessed := false
+		for _, policy := range policies[name] {
+			if len(policy.Labels) >= 2 && len(assessments[policy.Labels[1]]) > 0 {
+				assessed = true
+				break
+			}
+		}
+		if assessed {
+			continue
+		}
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("SQL Server '%s' does not have an associated azurerm_mssql_server_vulnerability_assessment", name),
+			resource.DefRange,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
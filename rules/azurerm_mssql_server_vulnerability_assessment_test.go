@@ -0,0 +1,103 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermMssqlServerVulnerabilityAssessment(t *testing.T) {
+	rule := NewAzurermMssqlServerVulnerabilityAssessment()
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "vulnerability assessment missing",
+			Content: `
+resource "azurerm_mssql_server" "example" {
+}
+
+resource "azurerm_mssql_server_security_alert_policy" "example" {
+  resource_group_name = "example"
+  server_name         = azurerm_mssql_server.example.name
+  state               = "Enabled"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "SQL Server 'example' does not have an associated azurerm_mssql_server_vulnerability_assessment",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 42},
+					},
+				},
+			},
+		},
+		{
+			Name: "vulnerability assessment of the security alert policy",
+			Content: `
+resource "azurerm_mssql_server" "example" {
+}
+
+resource "azurerm_mssql_server_security_alert_policy" "example" {
+  resource_group_name = "example"
+  server_name         = azurerm_mssql_server.example.name
+  state               = "Enabled"
+}
+
+resource "azurerm_mssql_server_vulnerability_assessment" "example" {
+  server_security_alert_policy_id = azurerm_mssql_server_security_alert_policy.example.id
+  storage_container_path          = "https://example.blob.core.windows.net/assessments/"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "vulnerability assessment of another server",
+			Content: `
+resource "azurerm_mssql_server" "example" {
+}
+
+resource "azurerm_mssql_server" "other" {
+}
+
+resource "azurerm_mssql_server_security_alert_policy" "other" {
+  resource_group_name = "example"
+  server_name         = azurerm_mssql_server.other.name
+  state               = "Enabled"
+}
+
+resource "azurerm_mssql_server_vulnerability_assessment" "other" {
+  server_security_alert_policy_id = azurerm_mssql_server_security_alert_policy.other.id
+  storage_container_path          = "https://example.blob.core.windows.net/assessments/"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "SQL Server 'example' does not have an associated azurerm_mssql_server_vulnerability_assessment",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 42},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
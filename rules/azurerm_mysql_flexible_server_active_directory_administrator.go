@@ -0,0 +1,56 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+)
+
+// mysqlFlexibleServerActiveDirectoryAdministrator enables Microsoft Entra authentication on MySQL flexible servers
+var mysqlFlexibleServerActiveDirectoryAdministrator = association{
+	ResourceType: "azurerm_mysql_flexible_server_active_directory_administrator",
+	Path:         []string{"server_id"},
+}
+
+// AzurermMysqlFlexibleServerActiveDirectoryAdministrator checks that MySQL flexible servers have a Microsoft Entra administrator
+type AzurermMysqlFlexibleServerActiveDirectoryAdministrator struct {
+	tflint.DefaultRule
+
+	resourceType string
+}
+
+// NewAzurermMysqlFlexibleServerActiveDirectoryAdministrator returns a new rule instance
+func NewAzurermMysqlFlexibleServerActiveDirectoryAdministrator() *AzurermMysqlFlexibleServerActiveDirectoryAdministrator {
+	return &AzurermMysqlFlexibleServerActiveDirectoryAdministrator{
+		resourceType: "azurerm_mysql_flexible_server",
+	}
+}
+
+// Name returns the rule name
+func (r *AzurermMysqlFlexibleServerActiveDirectoryAdministrator) Name() string {
+	return "azurerm_mysql_flexible_server_active_directory_administrator"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AzurermMysqlFlexibleServerActiveDirectoryAdministrator) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AzurermMysqlFlexibleServerActiveDirectoryAdministrator) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AzurermMysqlFlexibleServerActiveDirectoryAdministrator) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks that MySQL flexible servers are the server_id of an azurerm_mysql_flexible_server_active_directory_administrator
+func (r *AzurermMysqlFlexibleServerActiveDirectoryAdministrator) Check(runner tflint.Runner) error {
+	return checkAssociated(runner, r, r.resourceType, mysqlFlexibleServerActiveDirectoryAdministrator, func(name string) string {
+		return fmt.Sprintf("MySQL Flexible Server '%s' does not have an associated azurerm_mysql_flexible_server_active_directory_administrator", name)
+	})
+}
@@ -0,0 +1,59 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermMysqlFlexibleServerActiveDirectoryAdministrator(t *testing.T) {
+	rule := NewAzurermMysqlFlexibleServerActiveDirectoryAdministrator()
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "administrator missing",
+			Content: `
+resource "azurerm_mysql_flexible_server" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "MySQL Flexible Server 'example' does not have an associated azurerm_mysql_flexible_server_active_directory_administrator",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 51},
+					},
+				},
+			},
+		},
+		{
+			Name: "administrator",
+			Content: `
+resource "azurerm_mysql_flexible_server" "example" {
+}
+
+resource "azurerm_mysql_flexible_server_active_directory_administrator" "example" {
+  server_id = azurerm_mysql_flexible_server.example.id
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermMysqlFlexibleServerGeoRedundantBackupEnabled(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_mysql_flexible_server_geo_redundant_backup_enabled")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "geo-redundant backup missing",
+			Content: `
+resource "azurerm_mysql_flexible_server" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "geo_redundant_backup_enabled is not defined and should be true",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 51},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
+
+func Test_AzurermMysqlFlexibleServerGeoRedundantBackupEnabledFix(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_mysql_flexible_server_geo_redundant_backup_enabled")
+
+	tests := []struct {
+		Name    string
+		Content string
+	}{
+		{
+			Name: "geo-redundant backup disabled",
+			Content: `
+resource "azurerm_mysql_flexible_server" "example" {
+  geo_redundant_backup_enabled = false
+}`,
+		},
+		{
+			Name: "geo_redundant_backup_enabled attribute missing",
+			Content: `
+resource "azurerm_mysql_flexible_server" "example" {
+}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertChanges(t, map[string]string{}, runner.Changes())
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermMysqlFlexibleServerPublicNetworkAccessEnabled(t *testing.T) {
+	rule := findRule[*FlexibleServerPublicNetworkAccess](t, NewFlexibleServerPublicNetworkAccessRules(), "azurerm_mysql_flexible_server_public_network_access_enabled")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "MySQL public network access disabled",
+			Content: `
+resource "azurerm_mysql_flexible_server" "example" {
+  public_network_access = "Disabled"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "MySQL public network access missing",
+			Content: `
+resource "azurerm_mysql_flexible_server" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "public_network_access is not defined and defaults to Enabled, consider setting it to Disabled or setting delegated_subnet_id",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 51},
+					},
+				},
+			},
+		},
+		{
+			Name: "MySQL public network access enabled",
+			Content: `
+resource "azurerm_mysql_flexible_server" "example" {
+  public_network_access = "Enabled"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "public_network_access is set to Enabled, consider disabling it or setting delegated_subnet_id",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 27},
+						End:      hcl.Pos{Line: 3, Column: 36},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
+
+func Test_AzurermMysqlFlexibleServerPublicNetworkAccessEnabledFix(t *testing.T) {
+	rule := findRule[*FlexibleServerPublicNetworkAccess](t, NewFlexibleServerPublicNetworkAccessRules(), "azurerm_mysql_flexible_server_public_network_access_enabled")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected string
+	}{
+		{
+			Name: "MySQL public network access missing",
+			Content: `
+resource "azurerm_mysql_flexible_server" "example" {
+}`,
+			Expected: `
+resource "azurerm_mysql_flexible_server" "example" {
+  public_network_access = "Disabled"
+}`,
+		},
+		{
+			Name: "MySQL public network access enabled",
+			Content: `
+resource "azurerm_mysql_flexible_server" "example" {
+  public_network_access = "Enabled"
+}`,
+			Expected: `
+resource "azurerm_mysql_flexible_server" "example" {
+  public_network_access = "Disabled"
+}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertChanges(t, map[string]string{"resource.tf": test.Expected}, runner.Changes())
+		})
+	}
+}
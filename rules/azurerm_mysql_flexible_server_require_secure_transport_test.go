@@ -0,0 +1,84 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermMysqlFlexibleServerRequireSecureTransport(t *testing.T) {
+	rule := findRule[*FlexibleServerConfiguration](t, NewFlexibleServerConfigurationRules(), "azurerm_mysql_flexible_server_require_secure_transport")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "MySQL server without configuration",
+			Content: `
+resource "azurerm_mysql_flexible_server" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "No azurerm_mysql_flexible_server_configuration sets require_secure_transport for 'example', it should be set to ON",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 51},
+					},
+				},
+			},
+		},
+		{
+			Name: "MySQL secure transport disabled",
+			Content: `
+resource "azurerm_mysql_flexible_server" "example" {
+}
+
+resource "azurerm_mysql_flexible_server_configuration" "example" {
+  name        = "require_secure_transport"
+  server_name = azurerm_mysql_flexible_server.example.name
+  value       = "OFF"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "require_secure_transport is set to OFF for 'example', it should be set to ON",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 8, Column: 17},
+						End:      hcl.Pos{Line: 8, Column: 22},
+					},
+				},
+			},
+		},
+		{
+			Name: "MySQL secure transport enabled",
+			Content: `
+resource "azurerm_mysql_flexible_server" "example" {
+}
+
+resource "azurerm_mysql_flexible_server_configuration" "example" {
+  name        = "require_secure_transport"
+  server_name = azurerm_mysql_flexible_server.example.name
+  value       = "ON"
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermMysqlFlexibleServerTLSVersion(t *testing.T) {
+	rule := findRule[*FlexibleServerConfiguration](t, NewFlexibleServerConfigurationRules(), "azurerm_mysql_flexible_server_tls_version")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "MySQL server without configuration",
+			Content: `
+resource "azurerm_mysql_flexible_server" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "No azurerm_mysql_flexible_server_configuration sets tls_version for 'example', it should be set to TLSv1.2 or TLSv1.3",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 51},
+					},
+				},
+			},
+		},
+		{
+			Name: "MySQL server without configuration below the allowed versions",
+			Content: `
+resource "azurerm_mysql_flexible_server" "example" {
+}`,
+			Config: `
+rule "azurerm_mysql_flexible_server_tls_version" {
+  enabled          = true
+  allowed_versions = ["TLSv1.3"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "No azurerm_mysql_flexible_server_configuration sets tls_version for 'example', it should be set to TLSv1.3",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 51},
+					},
+				},
+			},
+		},
+		{
+			Name: "MySQL TLS versions",
+			Content: `
+resource "azurerm_mysql_flexible_server" "example" {
+}
+
+resource "azurerm_mysql_flexible_server_configuration" "example" {
+  name                = "tls_version"
+  resource_group_name = "example"
+  server_name         = azurerm_mysql_flexible_server.example.name
+  value               = "TLSv1.1,TLSv1.2"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "tls_version is set to TLSv1.1,TLSv1.2 for 'example', it should be set to TLSv1.2 or TLSv1.3",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 9, Column: 25},
+						End:      hcl.Pos{Line: 9, Column: 42},
+					},
+				},
+			},
+		},
+		{
+			Name: "MySQL TLS versions allowed by allowed_versions",
+			Content: `
+resource "azurerm_mysql_flexible_server" "example" {
+}
+
+resource "azurerm_mysql_flexible_server_configuration" "example" {
+  name                = "tls_version"
+  resource_group_name = "example"
+  server_name         = azurerm_mysql_flexible_server.example.name
+  value               = "TLSv1.1,TLSv1.2"
+}`,
+			Config: `
+rule "azurerm_mysql_flexible_server_tls_version" {
+  enabled          = true
+  allowed_versions = ["TLSv1.1", "TLSv1.2"]
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content, ".tflint.hcl": test.Config})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
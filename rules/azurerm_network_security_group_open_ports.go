@@ -0,0 +1,86 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+)
+
+// AzurermNetworkSecurityGroupOpenPorts checks that the security_rule blocks of network security groups
+// do not open management or database ports to the internet
+type AzurermNetworkSecurityGroupOpenPorts struct {
+	tflint.DefaultRule
+
+	resourceType string
+	blockType    string
+}
+
+// NewAzurermNetworkSecurityGroupOpenPorts returns a new rule instance
+func NewAzurermNetworkSecurityGroupOpenPorts() *AzurermNetworkSecurityGroupOpenPorts {
+	return &AzurermNetworkSecurityGroupOpenPorts{
+		resourceType: "azurerm_network_security_group",
+		blockType:    "security_rule",
+	}
+}
+
+// Name returns the rule name
+func (r *AzurermNetworkSecurityGroupOpenPorts) Name() string {
+	return "azurerm_network_security_group_open_ports"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AzurermNetworkSecurityGroupOpenPorts) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity, that of the management ports.
+// Issues of other port classes are reported with the severity of their class.
+func (r *AzurermNetworkSecurityGroupOpenPorts) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AzurermNetworkSecurityGroupOpenPorts) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks that inbound allow security_rule blocks from the internet do not include management or database ports
+func (r *AzurermNetworkSecurityGroupOpenPorts) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: r.blockType,
+				Body: &hclext.BodySchema{Attributes: securityRuleAttributes},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		for i, securityRule := range resource.Body.Blocks.OfType(r.blockType) {
+			name := fmt.Sprintf("#%d", i+1)
+			if values, known, err := evaluateSecurityRuleAttribute(runner, securityRule.Body.Attributes, "name"); err != nil {
+				return err
+			} else if known && len(values) == 1 {
+				name = values[0]
+			}
+
+			if err := emitOpenPortIssues(
+				runner,
+				r,
+				securityRule.Body.Attributes,
+				fmt.Sprintf("Network security group '%s' security rule '%s'", resource.Labels[1], name),
+				securityRule.DefRange,
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+func Test_AzurermNetworkSecurityGroupOpenPorts(t *testing.T) {
+	rule := NewAzurermNetworkSecurityGroupOpenPorts()
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "inline SSH rule from the internet",
+			Content: `
+resource "azurerm_network_security_group" "example" {
+  security_rule {
+    name                   = "ssh"
+    direction              = "Inbound"
+    access                 = "Allow"
+    protocol               = "Tcp"
+    source_address_prefix  = "Internet"
+    destination_port_range = "22"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "Network security group 'example' security rule 'ssh' allows inbound traffic from Internet to the management port 22 (SSH)",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 16},
+					},
+				},
+			},
+		},
+		{
+			Name: "database rule among other rules",
+			Content: `
+resource "azurerm_network_security_group" "example" {
+  security_rule {
+    name                   = "https"
+    direction              = "Inbound"
+    access                 = "Allow"
+    protocol               = "Tcp"
+    source_address_prefix  = "*"
+    destination_port_range = "443"
+  }
+
+  security_rule {
+    direction               = "Inbound"
+    access                  = "Allow"
+    protocol                = "Tcp"
+    source_address_prefix   = "*"
+    destination_port_ranges = ["5432", "6379"]
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    &severityRule{Rule: rule, severity: tflint.WARNING},
+					Message: "Network security group 'example' security rule '#2' allows inbound traffic from * to the database ports 5432 (PostgreSQL), 6379 (Redis)",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 12, Column: 3},
+						End:      hcl.Pos{Line: 12, Column: 16},
+					},
+				},
+			},
+		},
+		{
+			Name: "no inline rules",
+			Content: `
+resource "azurerm_network_security_group" "example" {
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
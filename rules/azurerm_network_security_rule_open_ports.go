@@ -0,0 +1,69 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+)
+
+// AzurermNetworkSecurityRuleOpenPorts checks that network security rules do not open management or database ports to the internet
+type AzurermNetworkSecurityRuleOpenPorts struct {
+	tflint.DefaultRule
+
+	resourceType string
+}
+
+// NewAzurermNetworkSecurityRuleOpenPorts returns a new rule instance
+func NewAzurermNetworkSecurityRuleOpenPorts() *AzurermNetworkSecurityRuleOpenPorts {
+	return &AzurermNetworkSecurityRuleOpenPorts{
+		resourceType: "azurerm_network_security_rule",
+	}
+}
+
+// Name returns the rule name
+func (r *AzurermNetworkSecurityRuleOpenPorts) Name() string {
+	return "azurerm_network_security_rule_open_ports"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AzurermNetworkSecurityRuleOpenPorts) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity, that of the management ports.
+// Issues of other port classes are reported with the severity of their class.
+func (r *AzurermNetworkSecurityRuleOpenPorts) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AzurermNetworkSecurityRuleOpenPorts) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks that inbound allow rules from the internet do not include management or database ports
+func (r *AzurermNetworkSecurityRuleOpenPorts) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: securityRuleAttributes,
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		if err := emitOpenPortIssues(
+			runner,
+			r,
+			resource.Body.Attributes,
+			fmt.Sprintf("Network security rule '%s'", resource.Labels[1]),
+			resource.DefRange,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,240 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+func Test_AzurermNetworkSecurityRuleOpenPorts(t *testing.T) {
+	rule := NewAzurermNetworkSecurityRuleOpenPorts()
+
+	defRange := hcl.Range{
+		Filename: "resource.tf",
+		Start:    hcl.Pos{Line: 2, Column: 1},
+		End:      hcl.Pos{Line: 2, Column: 51},
+	}
+
+	tests := []struct {
+		Name       string
+		Content    string
+		Expected   helper.Issues
+		Severities []tflint.Severity
+	}{
+		{
+			Name: "SSH from the internet",
+			Content: `
+resource "azurerm_network_security_rule" "example" {
+  direction              = "Inbound"
+  access                 = "Allow"
+  protocol               = "Tcp"
+  source_address_prefix  = "Internet"
+  destination_port_range = "22"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "Network security rule 'example' allows inbound traffic from Internet to the management port 22 (SSH)",
+					Range:   defRange,
+				},
+			},
+			Severities: []tflint.Severity{tflint.ERROR},
+		},
+		{
+			Name: "port range including RDP and database ports",
+			Content: `
+resource "azurerm_network_security_rule" "example" {
+  direction              = "Inbound"
+  access                 = "Allow"
+  protocol               = "*"
+  source_address_prefix  = "0.0.0.0/0"
+  destination_port_range = "1000-4000"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "Network security rule 'example' allows inbound traffic from 0.0.0.0/0 to the management port 3389 (RDP)",
+					Range:   defRange,
+				},
+				{
+					Rule:    &severityRule{Rule: rule, severity: tflint.WARNING},
+					Message: "Network security rule 'example' allows inbound traffic from 0.0.0.0/0 to the database ports 1433 (SQL Server), 1521 (Oracle), 3306 (MySQL)",
+					Range:   defRange,
+				},
+			},
+			Severities: []tflint.Severity{tflint.ERROR, tflint.WARNING},
+		},
+		{
+			Name: "list of port ranges",
+			Content: `
+resource "azurerm_network_security_rule" "example" {
+  direction               = "Inbound"
+  access                  = "Allow"
+  protocol                = "Tcp"
+  source_address_prefix   = "*"
+  destination_port_ranges = ["443", "5985-5986"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "Network security rule 'example' allows inbound traffic from * to the management ports 5985 (WinRM), 5986 (WinRM over HTTPS)",
+					Range:   defRange,
+				},
+			},
+			Severities: []tflint.Severity{tflint.ERROR},
+		},
+		{
+			Name: "all ports from a list of source prefixes",
+			Content: `
+resource "azurerm_network_security_rule" "example" {
+  direction               = "Inbound"
+  access                  = "Allow"
+  protocol                = "Tcp"
+  source_address_prefixes = ["10.0.0.0/8", "::/0"]
+  destination_port_range  = "*"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "Network security rule 'example' allows inbound traffic from ::/0 to all ports, including the management ports",
+					Range:   defRange,
+				},
+				{
+					Rule:    &severityRule{Rule: rule, severity: tflint.WARNING},
+					Message: "Network security rule 'example' allows inbound traffic from ::/0 to all ports, including the database ports",
+					Range:   defRange,
+				},
+			},
+			Severities: []tflint.Severity{tflint.ERROR, tflint.WARNING},
+		},
+		{
+			Name: "web ports from the internet",
+			Content: `
+resource "azurerm_network_security_rule" "example" {
+  direction               = "Inbound"
+  access                  = "Allow"
+  protocol                = "Tcp"
+  source_address_prefix   = "Internet"
+  destination_port_ranges = ["80", "443"]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "SSH from a private range",
+			Content: `
+resource "azurerm_network_security_rule" "example" {
+  direction              = "Inbound"
+  access                 = "Allow"
+  protocol               = "Tcp"
+  source_address_prefix  = "10.0.0.0/16"
+  destination_port_range = "22"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "deny rule",
+			Content: `
+resource "azurerm_network_security_rule" "example" {
+  direction              = "Inbound"
+  access                 = "Deny"
+  protocol               = "Tcp"
+  source_address_prefix  = "Internet"
+  destination_port_range = "22"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "outbound rule",
+			Content: `
+resource "azurerm_network_security_rule" "example" {
+  direction              = "Outbound"
+  access                 = "Allow"
+  protocol               = "Tcp"
+  source_address_prefix  = "*"
+  destination_port_range = "22"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "ICMP rule",
+			Content: `
+resource "azurerm_network_security_rule" "example" {
+  direction              = "Inbound"
+  access                 = "Allow"
+  protocol               = "Icmp"
+  source_address_prefix  = "*"
+  destination_port_range = "*"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "unknown source prefix",
+			Content: `
+variable "source" {
+  type = string
+}
+
+resource "azurerm_network_security_rule" "example" {
+  direction              = "Inbound"
+  access                 = "Allow"
+  protocol               = "Tcp"
+  source_address_prefix  = var.source
+  destination_port_range = "22"
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+			for i, severity := range test.Severities {
+				if got := runner.Issues[i].Rule.Severity(); got != severity {
+					t.Errorf("Expected issue %d to have severity %s, got %s", i, severity, got)
+				}
+			}
+		})
+	}
+}
+
+func Test_ParsePortRange(t *testing.T) {
+	tests := []struct {
+		Value    string
+		Expected portRange
+		Error    bool
+	}{
+		{Value: "22", Expected: portRange{From: 22, To: 22}},
+		{Value: "1000-4000", Expected: portRange{From: 1000, To: 4000}},
+		{Value: " 80 - 81 ", Expected: portRange{From: 80, To: 81}},
+		{Value: "*", Expected: allPorts},
+		{Value: "4000-1000", Error: true},
+		{Value: "65536", Error: true},
+		{Value: "ssh", Error: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Value, func(t *testing.T) {
+			got, err := parsePortRange(test.Value)
+			if test.Error {
+				if err == nil {
+					t.Errorf("Expected an error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+			if got != test.Expected {
+				t.Errorf("Expected %v, got %v", test.Expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermPostgresqlFlexibleServerGeoRedundantBackupEnabled(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_postgresql_flexible_server_geo_redundant_backup_enabled")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "geo-redundant backup enabled",
+			Content: `
+resource "azurerm_postgresql_flexible_server" "example" {
+  geo_redundant_backup_enabled = true
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
+
+func Test_AzurermPostgresqlFlexibleServerGeoRedundantBackupEnabledFix(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_postgresql_flexible_server_geo_redundant_backup_enabled")
+
+	tests := []struct {
+		Name    string
+		Content string
+	}{
+		{
+			Name: "geo-redundant backup disabled",
+			Content: `
+resource "azurerm_postgresql_flexible_server" "example" {
+  geo_redundant_backup_enabled = false
+}`,
+		},
+		{
+			Name: "geo_redundant_backup_enabled attribute missing",
+			Content: `
+resource "azurerm_postgresql_flexible_server" "example" {
+}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertChanges(t, map[string]string{}, runner.Changes())
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermPostgresqlFlexibleServerPasswordAuthEnabled(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_postgresql_flexible_server_password_auth_enabled")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "authentication block missing",
+			Content: `
+resource "azurerm_postgresql_flexible_server" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "authentication block is missing and password authentication defaults to enabled, password_auth_enabled should be false",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 56},
+					},
+				},
+			},
+		},
+		{
+			Name: "password authentication enabled",
+			Content: `
+resource "azurerm_postgresql_flexible_server" "example" {
+  authentication {
+    active_directory_auth_enabled = true
+    password_auth_enabled         = true
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "password_auth_enabled should be false",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 37},
+						End:      hcl.Pos{Line: 5, Column: 41},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermPostgresqlFlexibleServerPublicNetworkAccessEnabled(t *testing.T) {
+	rule := findRule[*FlexibleServerPublicNetworkAccess](t, NewFlexibleServerPublicNetworkAccessRules(), "azurerm_postgresql_flexible_server_public_network_access_enabled")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "public network access missing",
+			Content: `
+resource "azurerm_postgresql_flexible_server" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "public_network_access_enabled is not defined and defaults to true, consider disabling it or setting delegated_subnet_id",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 56},
+					},
+				},
+			},
+		},
+		{
+			Name: "public network access enabled",
+			Content: `
+resource "azurerm_postgresql_flexible_server" "example" {
+  public_network_access_enabled = true
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "Consider changing public_network_access_enabled to false or setting delegated_subnet_id",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 35},
+						End:      hcl.Pos{Line: 3, Column: 39},
+					},
+				},
+			},
+		},
+		{
+			Name: "virtual network integration",
+			Content: `
+resource "azurerm_postgresql_flexible_server" "example" {
+  delegated_subnet_id = azurerm_subnet.example.id
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
+
+func Test_AzurermPostgresqlFlexibleServerPublicNetworkAccessEnabledFix(t *testing.T) {
+	rule := findRule[*FlexibleServerPublicNetworkAccess](t, NewFlexibleServerPublicNetworkAccessRules(), "azurerm_postgresql_flexible_server_public_network_access_enabled")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected string
+	}{
+		{
+			Name: "PostgreSQL public network access missing",
+			Content: `
+resource "azurerm_postgresql_flexible_server" "example" {
+}`,
+			Expected: `
+resource "azurerm_postgresql_flexible_server" "example" {
+  public_network_access_enabled = false
+}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertChanges(t, map[string]string{"resource.tf": test.Expected}, runner.Changes())
+		})
+	}
+}
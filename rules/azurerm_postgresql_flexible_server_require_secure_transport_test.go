@@ -0,0 +1,84 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermPostgresqlFlexibleServerRequireSecureTransport(t *testing.T) {
+	rule := findRule[*FlexibleServerConfiguration](t, NewFlexibleServerConfigurationRules(), "azurerm_postgresql_flexible_server_require_secure_transport")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "PostgreSQL server without configuration",
+			Content: `
+resource "azurerm_postgresql_flexible_server" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "No azurerm_postgresql_flexible_server_configuration sets require_secure_transport for 'example', it should be set to on",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 56},
+					},
+				},
+			},
+		},
+		{
+			Name: "PostgreSQL secure transport disabled",
+			Content: `
+resource "azurerm_postgresql_flexible_server" "example" {
+}
+
+resource "azurerm_postgresql_flexible_server_configuration" "example" {
+  name      = "require_secure_transport"
+  server_id = azurerm_postgresql_flexible_server.example.id
+  value     = "off"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "require_secure_transport is set to off for 'example', it should be set to on",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 8, Column: 15},
+						End:      hcl.Pos{Line: 8, Column: 20},
+					},
+				},
+			},
+		},
+		{
+			Name: "PostgreSQL secure transport enabled",
+			Content: `
+resource "azurerm_postgresql_flexible_server" "example" {
+}
+
+resource "azurerm_postgresql_flexible_server_configuration" "example" {
+  name      = "require_secure_transport"
+  server_id = azurerm_postgresql_flexible_server.example.id
+  value     = "ON"
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermPostgresqlFlexibleServerSSLMinProtocolVersion(t *testing.T) {
+	rule := findRule[*FlexibleServerConfiguration](t, NewFlexibleServerConfigurationRules(), "azurerm_postgresql_flexible_server_ssl_min_protocol_version")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "PostgreSQL configuration of another parameter",
+			Content: `
+resource "azurerm_postgresql_flexible_server" "example" {
+}
+
+resource "azurerm_postgresql_flexible_server_configuration" "example" {
+  name      = "require_secure_transport"
+  server_id = azurerm_postgresql_flexible_server.example.id
+  value     = "on"
+}`,
+			Config: `
+rule "azurerm_postgresql_flexible_server_ssl_min_protocol_version" {
+  enabled          = true
+  allowed_versions = ["TLSv1.3"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "No azurerm_postgresql_flexible_server_configuration sets ssl_min_protocol_version for 'example', it should be set to TLSv1.3",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 56},
+					},
+				},
+			},
+		},
+		{
+			Name: "PostgreSQL configurations from for_each",
+			Content: `
+resource "azurerm_postgresql_flexible_server" "example" {
+}
+
+resource "azurerm_postgresql_flexible_server_configuration" "example" {
+  for_each  = { require_secure_transport = "on", ssl_min_protocol_version = "TLSv1.2" }
+  name      = each.key
+  server_id = azurerm_postgresql_flexible_server.example.id
+  value     = each.value
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content, ".tflint.hcl": test.Config})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
 
 	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
 )
@@ -18,6 +19,11 @@ type AzurermRedisCacheMinimumTLSVersion struct {
 	version      string
 }
 
+// azurermRedisCacheMinimumTLSVersionConfig is the `rule` block of the rule
+type azurermRedisCacheMinimumTLSVersionConfig struct {
+	Version string `hclext:"version,optional"`
+}
+
 // NewAzurermRedisCacheMinimumTLSVersion returns a new rule instance
 func NewAzurermRedisCacheMinimumTLSVersion() *AzurermRedisCacheMinimumTLSVersion {
 	return &AzurermRedisCacheMinimumTLSVersion{
@@ -47,8 +53,18 @@ func (r *AzurermRedisCacheMinimumTLSVersion) Link() string {
 	return project.ReferenceLink(r.Name())
 }
 
+// ApplyConfig applies the plugin-wide minimum TLS version
+func (r *AzurermRedisCacheMinimumTLSVersion) ApplyConfig(config *Config) {
+	r.version = tlsVersionsAtLeast(config.MinimumTLSVersion, redisTLSVersions)[0]
+}
+
 // Check verifies that minimum_tls_version is at least "1.2"
 func (r *AzurermRedisCacheMinimumTLSVersion) Check(runner tflint.Runner) error {
+	config := azurermRedisCacheMinimumTLSVersionConfig{Version: r.version}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
 	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
 		Attributes: []hclext.AttributeSchema{
 			{Name: r.attribute},
@@ -61,21 +77,27 @@ func (r *AzurermRedisCacheMinimumTLSVersion) Check(runner tflint.Runner) error {
 	for _, resource := range resources.Blocks {
 		attribute, exists := resource.Body.Attributes[r.attribute]
 		if !exists {
-			runner.EmitIssue(
+			if err := runner.EmitIssueWithFix(
 				r,
-				"minimum_tls_version is missing, should be set to 1.2 or higher",
+				fmt.Sprintf("minimum_tls_version is missing, should be set to %s or higher", config.Version),
 				resource.DefRange,
-			)
+				fixInsertAttribute(runner, resource, r.attribute, cty.StringVal(config.Version)),
+			); err != nil {
+				return err
+			}
 			continue
 		}
 
 		err := runner.EvaluateExpr(attribute.Expr, func(val string) error {
-			if val != r.version {
-				runner.EmitIssue(
+			if val != config.Version {
+				if err := runner.EmitIssueWithFix(
 					r,
-					fmt.Sprintf("minimum_tls_version is set to %s, should be %s or higher", val, r.version),
+					fmt.Sprintf("minimum_tls_version is set to %s, should be %s or higher", val, config.Version),
 					attribute.Expr.Range(),
-				)
+					fixReplaceLiteral(attribute, cty.StringVal(config.Version)),
+				); err != nil {
+					return err
+				}
 			}
 			return nil
 		}, nil)
@@ -11,6 +11,7 @@ func Test_AzurermRedisCacheMinimumTLSVersion(t *testing.T) {
 	tests := []struct {
 		Name     string
 		Content  string
+		Config   string
 		Expected helper.Issues
 	}{
 		{
@@ -68,13 +69,26 @@ resource "azurerm_redis_cache" "example" {
 				},
 			},
 		},
+		{
+			Name: "minimum_tls_version below configured version",
+			Content: `
+resource "azurerm_redis_cache" "example" {
+    minimum_tls_version = "1.1"
+}`,
+			Config: `
+rule "azurerm_redis_cache_minimum_tls_version" {
+    enabled = true
+    version = "1.1"
+}`,
+			Expected: helper.Issues{},
+		},
 	}
 
 	rule := NewAzurermRedisCacheMinimumTLSVersion()
 
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
-			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content, ".tflint.hcl": test.Config})
 
 			if err := rule.Check(runner); err != nil {
 				t.Fatalf("Unexpected error occurred: %s", err)
@@ -56,6 +56,122 @@ resource "azurerm_redis_cache" "example" {
 }`,
 			Expected: helper.Issues{},
 		},
+		{
+			Name: "azurerm v3 name with azurerm v3",
+			Content: `
+terraform {
+  required_providers {
+    azurerm = {
+      source  = "hashicorp/azurerm"
+      version = "~> 3.0"
+    }
+  }
+}
+
+resource "azurerm_redis_cache" "example" {
+    enable_non_ssl_port = false
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "azurerm v3 name with azurerm v3 set to true",
+			Content: `
+terraform {
+  required_providers {
+    azurerm = {
+      source  = "hashicorp/azurerm"
+      version = "~> 3.0"
+    }
+  }
+}
+
+resource "azurerm_redis_cache" "example" {
+    enable_non_ssl_port = true
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAzurermRedisCacheNonSSLPortEnabled(),
+					Message: "enable_non_ssl_port should be false",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 12, Column: 27},
+						End:      hcl.Pos{Line: 12, Column: 31},
+					},
+				},
+			},
+		},
+		{
+			Name: "attribute missing with azurerm v3",
+			Content: `
+terraform {
+  required_providers {
+    azurerm = {
+      source  = "hashicorp/azurerm"
+      version = "~> 3.0"
+    }
+  }
+}
+
+resource "azurerm_redis_cache" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAzurermRedisCacheNonSSLPortEnabled(),
+					Message: "enable_non_ssl_port is not defined and should be false",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 11, Column: 1},
+						End:      hcl.Pos{Line: 11, Column: 41},
+					},
+				},
+			},
+		},
+		{
+			Name: "azurerm v4 name with azurerm v3",
+			Content: `
+terraform {
+  required_providers {
+    azurerm = {
+      source  = "hashicorp/azurerm"
+      version = "~> 3.0"
+    }
+  }
+}
+
+resource "azurerm_redis_cache" "example" {
+    non_ssl_port_enabled = false
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAzurermRedisCacheNonSSLPortEnabled(),
+					Message: "non_ssl_port_enabled is the azurerm v4 name of enable_non_ssl_port, the configuration uses azurerm v3",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 12, Column: 5},
+						End:      hcl.Pos{Line: 12, Column: 25},
+					},
+				},
+			},
+		},
+		{
+			Name: "names of both versions",
+			Content: `
+resource "azurerm_redis_cache" "example" {
+    non_ssl_port_enabled = false
+    enable_non_ssl_port = false
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAzurermRedisCacheNonSSLPortEnabled(),
+					Message: "enable_non_ssl_port is the azurerm v3 name of non_ssl_port_enabled, the configuration uses azurerm v4",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 5},
+						End:      hcl.Pos{Line: 4, Column: 24},
+					},
+				},
+			},
+		},
 	}
 
 	rule := NewAzurermRedisCacheNonSSLPortEnabled()
@@ -72,3 +188,50 @@ resource "azurerm_redis_cache" "example" {
 		})
 	}
 }
+
+func Test_AzurermRedisCacheNonSSLPortEnabledFix(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected string
+	}{
+		{
+			Name: "rewrite non_ssl_port_enabled",
+			Content: `
+resource "azurerm_redis_cache" "example" {
+  non_ssl_port_enabled = true
+}`,
+			Expected: `
+resource "azurerm_redis_cache" "example" {
+  non_ssl_port_enabled = false
+}`,
+		},
+		{
+			Name: "insert non_ssl_port_enabled",
+			Content: `
+resource "azurerm_redis_cache" "example" {}`,
+			Expected: `
+resource "azurerm_redis_cache" "example" {
+  non_ssl_port_enabled = false
+}`,
+		},
+	}
+
+	rule := NewAzurermRedisCacheNonSSLPortEnabled()
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			expected := map[string]string{}
+			if test.Expected != "" {
+				expected["resource.tf"] = test.Expected
+			}
+			helper.AssertChanges(t, expected, runner.Changes())
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermServicebusNamespaceAuthorizationRuleManage(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_servicebus_namespace_authorization_rule_manage")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "namespace authorization rule with manage rights",
+			Content: `
+resource "azurerm_servicebus_namespace_authorization_rule" "example" {
+  namespace_id = azurerm_servicebus_namespace.example.id
+  listen       = true
+  send         = true
+  manage       = true
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "manage should be false, Manage rights allow changing the entity and its authorization rules, grant listen or send only",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 18},
+						End:      hcl.Pos{Line: 6, Column: 22},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
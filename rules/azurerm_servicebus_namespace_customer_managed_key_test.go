@@ -0,0 +1,70 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermServicebusNamespaceCustomerManagedKey(t *testing.T) {
+	rule := findRule[*CustomerManagedKey](t, NewCustomerManagedKeyRules(), "azurerm_servicebus_namespace_customer_managed_key")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "customer-managed key missing on Premium",
+			Content: `
+resource "azurerm_servicebus_namespace" "example" {
+  sku = "Premium"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "customer_managed_key block is missing, the Service Bus Namespace should be encrypted with a customer-managed key, inline or with an azurerm_servicebus_namespace_customer_managed_key",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 50},
+					},
+				},
+			},
+		},
+		{
+			Name: "customer-managed key not supported on Standard",
+			Content: `
+resource "azurerm_servicebus_namespace" "example" {
+  sku = "Standard"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Service Bus namespace with azurerm_servicebus_namespace_customer_managed_key",
+			Content: `
+resource "azurerm_servicebus_namespace" "example" {
+  sku = "Premium"
+}
+
+resource "azurerm_servicebus_namespace_customer_managed_key" "example" {
+  namespace_id     = azurerm_servicebus_namespace.example.id
+  key_vault_key_id = azurerm_key_vault_key.example.id
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermServicebusNamespaceMinimumTLSVersion(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_servicebus_namespace_minimum_tls_version")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "TLS 1.0",
+			Content: `
+resource "azurerm_servicebus_namespace" "example" {
+  minimum_tls_version = "1.0"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "minimum_tls_version is set to 1.0, should be 1.2",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 25},
+						End:      hcl.Pos{Line: 3, Column: 30},
+					},
+				},
+			},
+		},
+		{
+			Name: "TLS 1.2",
+			Content: `
+resource "azurerm_servicebus_namespace" "example" {
+  minimum_tls_version = "1.2"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "minimum_tls_version attribute missing",
+			Content: `
+resource "azurerm_servicebus_namespace" "example" {
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
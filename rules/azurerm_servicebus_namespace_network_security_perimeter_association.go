@@ -0,0 +1,46 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+)
+
+// AzurermServicebusNamespaceNetworkSecurityPerimeterAssociation checks that Service Bus namespaces have an NSP association
+type AzurermServicebusNamespaceNetworkSecurityPerimeterAssociation struct {
+	tflint.DefaultRule
+
+	resourceType string
+}
+
+// NewAzurermServicebusNamespaceNetworkSecurityPerimeterAssociation returns a new rule instance
+func NewAzurermServicebusNamespaceNetworkSecurityPerimeterAssociation() *AzurermServicebusNamespaceNetworkSecurityPerimeterAssociation {
+	return &AzurermServicebusNamespaceNetworkSecurityPerimeterAssociation{
+		resourceType: "azurerm_servicebus_namespace",
+	}
+}
+
+// Name returns the rule name
+func (r *AzurermServicebusNamespaceNetworkSecurityPerimeterAssociation) Name() string {
+	return "azurerm_servicebus_namespace_network_security_perimeter_association"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AzurermServicebusNamespaceNetworkSecurityPerimeterAssociation) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AzurermServicebusNamespaceNetworkSecurityPerimeterAssociation) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AzurermServicebusNamespaceNetworkSecurityPerimeterAssociation) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks if Service Bus namespaces have an associated network security perimeter
+func (r *AzurermServicebusNamespaceNetworkSecurityPerimeterAssociation) Check(runner tflint.Runner) error {
+	return checkNetworkSecurityPerimeterAssociation(runner, r, r.resourceType, "Service Bus Namespace")
+}
@@ -0,0 +1,63 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermServicebusNamespaceNetworkSecurityPerimeterAssociation(t *testing.T) {
+	rule := NewAzurermServicebusNamespaceNetworkSecurityPerimeterAssociation()
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "NSP association missing",
+			Content: `
+resource "azurerm_servicebus_namespace" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "Service Bus Namespace 'example' does not have an associated azurerm_network_security_perimeter_association",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 50},
+					},
+				},
+			},
+		},
+		{
+			Name: "NSP association",
+			Content: `
+resource "azurerm_servicebus_namespace" "example" {
+}
+
+resource "azurerm_network_security_perimeter_association" "example" {
+  name        = azurerm_servicebus_namespace.example.name
+  access_mode = "Enforced"
+
+  network_security_perimeter_profile_id = azurerm_network_security_perimeter_profile.example.id
+  resource_id                           = azurerm_servicebus_namespace.example.id
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+)
+
+// AzurermServicebusNamespacePublicNetworkAccessEnabled checks that Service Bus namespaces are not reachable from all networks
+type AzurermServicebusNamespacePublicNetworkAccessEnabled struct {
+	tflint.DefaultRule
+
+	resourceType  string
+	attributeName string
+}
+
+// NewAzurermServicebusNamespacePublicNetworkAccessEnabled returns a new rule instance
+func NewAzurermServicebusNamespacePublicNetworkAccessEnabled() *AzurermServicebusNamespacePublicNetworkAccessEnabled {
+	return &AzurermServicebusNamespacePublicNetworkAccessEnabled{
+		resourceType:  "azurerm_servicebus_namespace",
+		attributeName: "public_network_access_enabled",
+	}
+}
+
+// Name returns the rule name
+func (r *AzurermServicebusNamespacePublicNetworkAccessEnabled) Name() string {
+	return "azurerm_servicebus_namespace_public_network_access_enabled"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AzurermServicebusNamespacePublicNetworkAccessEnabled) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AzurermServicebusNamespacePublicNetworkAccessEnabled) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *AzurermServicebusNamespacePublicNetworkAccessEnabled) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks that public network access is disabled or denied by default in the network_rule_set block
+func (r *AzurermServicebusNamespacePublicNetworkAccessEnabled) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.attributeName},
+		},
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "network_rule_set",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "default_action"}},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		denied, err := r.deniedByDefault(runner, resource)
+		if err != nil {
+			return err
+		}
+		if denied {
+			continue
+		}
+
+		attribute, exists := resource.Body.Attributes[r.attributeName]
+		if !exists {
+			if err := runner.EmitIssueWithFix(
+				r,
+				"public_network_access_enabled is not defined and defaults to true, consider disabling it or add network_rule_set block with default_action = Deny",
+				resource.DefRange,
+				fixInsertAttribute(runner, resource, r.attributeName, cty.False),
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := runner.EvaluateExpr(attribute.Expr, func(val bool) error {
+			if !val {
+				return nil
+			}
+			return runner.EmitIssueWithFix(
+				r,
+				"Consider changing public_network_access_enabled to false or add network_rule_set block with default_action = Deny",
+				attribute.Expr.Range(),
+				fixReplaceLiteral(attribute, cty.False),
+			)
+		}, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deniedByDefault returns whether the network_rule_set block denies the networks it does not allow.
+// Values that cannot be evaluated, such as unknown variables, are considered denied.
+func (r *AzurermServicebusNamespacePublicNetworkAccessEnabled) deniedByDefault(runner tflint.Runner, resource *hclext.Block) (bool, error) {
+	blocks := resource.Body.Blocks.OfType("network_rule_set")
+	if len(blocks) == 0 {
+		return false, nil
+	}
+	attribute, exists := blocks[0].Body.Attributes["default_action"]
+	if !exists {
+		// default_action defaults to Allow
+		return false, nil
+	}
+
+	denied := true
+	err := runner.EvaluateExpr(attribute.Expr, func(val string) error {
+		denied = val == "Deny"
+		return nil
+	}, nil)
+	return denied, err
+}
@@ -0,0 +1,89 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermServicebusNamespacePublicNetworkAccessEnabled(t *testing.T) {
+	rule := NewAzurermServicebusNamespacePublicNetworkAccessEnabled()
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "public network access missing",
+			Content: `
+resource "azurerm_servicebus_namespace" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "public_network_access_enabled is not defined and defaults to true, consider disabling it or add network_rule_set block with default_action = Deny",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 50},
+					},
+				},
+			},
+		},
+		{
+			Name: "public network access allowed by the network rule set",
+			Content: `
+resource "azurerm_servicebus_namespace" "example" {
+  public_network_access_enabled = true
+
+  network_rule_set {
+    default_action = "Allow"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "Consider changing public_network_access_enabled to false or add network_rule_set block with default_action = Deny",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 35},
+						End:      hcl.Pos{Line: 3, Column: 39},
+					},
+				},
+			},
+		},
+		{
+			Name: "public network access denied by the network rule set",
+			Content: `
+resource "azurerm_servicebus_namespace" "example" {
+  network_rule_set {
+    default_action = "Deny"
+    ip_rules       = ["203.0.113.0/24"]
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "public network access disabled",
+			Content: `
+resource "azurerm_servicebus_namespace" "example" {
+  public_network_access_enabled = false
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
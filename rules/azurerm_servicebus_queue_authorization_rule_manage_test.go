@@ -0,0 +1,39 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermServicebusQueueAuthorizationRuleManage(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_servicebus_queue_authorization_rule_manage")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "queue authorization rule without manage",
+			Content: `
+resource "azurerm_servicebus_queue_authorization_rule" "example" {
+  queue_id = azurerm_servicebus_queue.example.id
+  listen   = true
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
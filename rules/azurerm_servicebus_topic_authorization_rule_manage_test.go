@@ -0,0 +1,50 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermServicebusTopicAuthorizationRuleManage(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_servicebus_topic_authorization_rule_manage")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "topic authorization rule with manage rights",
+			Content: `
+resource "azurerm_servicebus_topic_authorization_rule" "example" {
+  topic_id = azurerm_servicebus_topic.example.id
+  manage   = true
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "manage should be false, Manage rights allow changing the entity and its authorization rules, grant listen or send only",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 14},
+						End:      hcl.Pos{Line: 4, Column: 18},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermStorageAccountAllowNestedItemsToBePublic(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_storage_account_allow_nested_items_to_be_public")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "nested items allowed to be public",
+			Content: `
+resource "azurerm_storage_account" "example" {
+  allow_nested_items_to_be_public = true
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "allow_nested_items_to_be_public should be false, containers could allow anonymous read access",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 37},
+						End:      hcl.Pos{Line: 3, Column: 41},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
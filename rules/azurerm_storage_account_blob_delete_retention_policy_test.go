@@ -0,0 +1,104 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermStorageAccountBlobDeleteRetentionPolicy(t *testing.T) {
+	rule := findRule[*StorageAccountDeleteRetentionPolicy](t, NewStorageAccountDeleteRetentionPolicyRules(), "azurerm_storage_account_blob_delete_retention_policy")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "blob_properties missing",
+			Content: `
+resource "azurerm_storage_account" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "blob_properties block is missing, delete_retention_policy should keep deleted blobs for at least 7 days",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 45},
+					},
+				},
+			},
+		},
+		{
+			Name: "blob soft delete too short",
+			Content: `
+resource "azurerm_storage_account" "example" {
+  blob_properties {
+    delete_retention_policy {
+      days = 1
+    }
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "days is 1 in delete_retention_policy, deleted blobs should be kept for at least 7 days",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 14},
+						End:      hcl.Pos{Line: 5, Column: 15},
+					},
+				},
+			},
+		},
+		{
+			Name: "blob soft delete with the default retention",
+			Content: `
+resource "azurerm_storage_account" "example" {
+  blob_properties {
+    delete_retention_policy {}
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
+
+func Test_AzurermStorageAccountBlobDeleteRetentionPolicyFix(t *testing.T) {
+	runner := helper.TestRunner(t, map[string]string{"resource.tf": `
+resource "azurerm_storage_account" "example" {
+  blob_properties {
+    delete_retention_policy {
+      days = 1
+    }
+  }
+}`})
+
+	if err := findRule[*StorageAccountDeleteRetentionPolicy](t, NewStorageAccountDeleteRetentionPolicyRules(), "azurerm_storage_account_blob_delete_retention_policy").Check(runner); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	helper.AssertChanges(t, map[string]string{"resource.tf": `
+resource "azurerm_storage_account" "example" {
+  blob_properties {
+    delete_retention_policy {
+      days = 7
+    }
+  }
+}`}, runner.Changes())
+}
@@ -0,0 +1,87 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermStorageAccountContainerDeleteRetentionPolicy(t *testing.T) {
+	rule := findRule[*StorageAccountDeleteRetentionPolicy](t, NewStorageAccountDeleteRetentionPolicyRules(), "azurerm_storage_account_container_delete_retention_policy")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "blob_properties not supported by file storage accounts",
+			Content: `
+resource "azurerm_storage_account" "example" {
+  account_kind = "FileStorage"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "container soft delete missing",
+			Content: `
+resource "azurerm_storage_account" "example" {
+  blob_properties {
+    delete_retention_policy {
+      days = 7
+    }
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "container_delete_retention_policy is missing in blob_properties, deleted containers should be kept for at least 7 days",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 18},
+					},
+				},
+			},
+		},
+		{
+			Name: "container soft delete with the default retention below the configured minimum",
+			Content: `
+resource "azurerm_storage_account" "example" {
+  blob_properties {
+    container_delete_retention_policy {}
+  }
+}`,
+			Config: `
+rule "azurerm_storage_account_container_delete_retention_policy" {
+  enabled                = true
+  minimum_retention_days = 30
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "days is not defined in container_delete_retention_policy and defaults to 7, deleted containers should be kept for at least 30 days",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 5},
+						End:      hcl.Pos{Line: 4, Column: 38},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content, ".tflint.hcl": test.Config})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package rules
 import (
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
 
 	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
 )
@@ -63,11 +64,14 @@ func (r *AzurermStorageAccountCrossTenantReplicationEnabled) Check(runner tflint
 
 		err := runner.EvaluateExpr(attribute.Expr, func(val bool) error {
 			if val {
-				runner.EmitIssue(
+				if err := runner.EmitIssueWithFix(
 					r,
 					"cross_tenant_replication_enabled should be false",
 					attribute.Expr.Range(),
-				)
+					fixReplaceLiteral(attribute, cty.False),
+				); err != nil {
+					return err
+				}
 			}
 			return nil
 		}, nil)
@@ -3,6 +3,7 @@ package rules
 import (
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
 
 	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
 )
@@ -58,21 +59,27 @@ func (r *AzurermStorageAccountDefaultToOAuthAuthentication) Check(runner tflint.
 		attribute, exists := resource.Body.Attributes[r.attributeName]
 		if !exists {
 			// Emit an issue if the attribute does not exist
-			runner.EmitIssue(
+			if err := runner.EmitIssueWithFix(
 				r,
 				"default_to_oauth_authentication is not defined and should be true",
 				resource.DefRange,
-			)
+				fixInsertAttribute(runner, resource, r.attributeName, cty.True),
+			); err != nil {
+				return err
+			}
 			continue
 		}
 
 		err := runner.EvaluateExpr(attribute.Expr, func(val bool) error {
 			if !val {
-				runner.EmitIssue(
+				if err := runner.EmitIssueWithFix(
 					r,
 					"default_to_oauth_authentication should be true",
 					attribute.Expr.Range(),
-				)
+					fixReplaceLiteral(attribute, cty.True),
+				); err != nil {
+					return err
+				}
 			}
 			return nil
 		}, nil)
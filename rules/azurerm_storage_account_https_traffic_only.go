@@ -1,8 +1,11 @@
 package rules
 
 import (
+	"fmt"
+
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
 
 	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
 )
@@ -45,34 +48,46 @@ func (r *AzurermStorageAccountHTTPSTrafficOnlyEnabled) Link() string {
 
 // Check checks if https_traffic_only is enabled for azurerm_storage_account
 func (r *AzurermStorageAccountHTTPSTrafficOnlyEnabled) Check(runner tflint.Runner) error {
+	versioned, err := newVersionedAttribute(runner, r.resourceType, r.attributeName)
+	if err != nil {
+		return err
+	}
+
 	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
-		Attributes: []hclext.AttributeSchema{
-			{Name: r.attributeName},
-		},
+		Attributes: versioned.Schema(),
 	}, nil)
 	if err != nil {
 		return err
 	}
 
 	for _, resource := range resources.Blocks {
-		attribute, exists := resource.Body.Attributes[r.attributeName]
+		attribute, exists, err := versioned.Lookup(runner, r, resource)
+		if err != nil {
+			return err
+		}
 		if !exists {
 			// Emit an issue if the attribute does not exist
-			runner.EmitIssue(
+			if err := runner.EmitIssueWithFix(
 				r,
-				"https_traffic_only_enabled is not defined and should be true",
+				fmt.Sprintf("%s is not defined and should be true", versioned.Name),
 				resource.DefRange,
-			)
+				fixInsertAttribute(runner, resource, versioned.Name, cty.True),
+			); err != nil {
+				return err
+			}
 			continue
 		}
 
-		err := runner.EvaluateExpr(attribute.Expr, func(val bool) error {
+		err = runner.EvaluateExpr(attribute.Expr, func(val bool) error {
 			if !val {
-				runner.EmitIssue(
+				if err := runner.EmitIssueWithFix(
 					r,
-					"https_traffic_only_enabled should be true",
+					fmt.Sprintf("%s should be true", attribute.Name),
 					attribute.Expr.Range(),
-				)
+					fixReplaceLiteral(attribute, cty.True),
+				); err != nil {
+					return err
+				}
 			}
 			return nil
 		}, nil)
@@ -56,6 +56,122 @@ resource "azurerm_storage_account" "example" {
 }`,
 			Expected: helper.Issues{},
 		},
+		{
+			Name: "azurerm v3 name with azurerm v3",
+			Content: `
+terraform {
+  required_providers {
+    azurerm = {
+      source  = "hashicorp/azurerm"
+      version = "~> 3.0"
+    }
+  }
+}
+
+resource "azurerm_storage_account" "example" {
+    enable_https_traffic_only = true
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "azurerm v3 name with azurerm v3 set to false",
+			Content: `
+terraform {
+  required_providers {
+    azurerm = {
+      source  = "hashicorp/azurerm"
+      version = "~> 3.0"
+    }
+  }
+}
+
+resource "azurerm_storage_account" "example" {
+    enable_https_traffic_only = false
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAzurermStorageAccountHTTPSTrafficOnlyEnabled(),
+					Message: "enable_https_traffic_only should be true",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 12, Column: 33},
+						End:      hcl.Pos{Line: 12, Column: 38},
+					},
+				},
+			},
+		},
+		{
+			Name: "attribute missing with azurerm v3",
+			Content: `
+terraform {
+  required_providers {
+    azurerm = {
+      source  = "hashicorp/azurerm"
+      version = "~> 3.0"
+    }
+  }
+}
+
+resource "azurerm_storage_account" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAzurermStorageAccountHTTPSTrafficOnlyEnabled(),
+					Message: "enable_https_traffic_only is not defined and should be true",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 11, Column: 1},
+						End:      hcl.Pos{Line: 11, Column: 45},
+					},
+				},
+			},
+		},
+		{
+			Name: "azurerm v4 name with azurerm v3",
+			Content: `
+terraform {
+  required_providers {
+    azurerm = {
+      source  = "hashicorp/azurerm"
+      version = "~> 3.0"
+    }
+  }
+}
+
+resource "azurerm_storage_account" "example" {
+    https_traffic_only_enabled = true
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAzurermStorageAccountHTTPSTrafficOnlyEnabled(),
+					Message: "https_traffic_only_enabled is the azurerm v4 name of enable_https_traffic_only, the configuration uses azurerm v3",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 12, Column: 5},
+						End:      hcl.Pos{Line: 12, Column: 31},
+					},
+				},
+			},
+		},
+		{
+			Name: "names of both versions",
+			Content: `
+resource "azurerm_storage_account" "example" {
+    https_traffic_only_enabled = true
+    enable_https_traffic_only = true
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAzurermStorageAccountHTTPSTrafficOnlyEnabled(),
+					Message: "enable_https_traffic_only is the azurerm v3 name of https_traffic_only_enabled, the configuration uses azurerm v4",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 5},
+						End:      hcl.Pos{Line: 4, Column: 30},
+					},
+				},
+			},
+		},
 	}
 
 	rule := NewAzurermStorageAccountHTTPSTrafficOnlyEnabled()
@@ -0,0 +1,83 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermStorageAccountInfrastructureEncryptionEnabled(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_storage_account_infrastructure_encryption_enabled")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "infrastructure encryption missing",
+			Content: `
+resource "azurerm_storage_account" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "infrastructure_encryption_enabled is not defined and should be true to encrypt the data twice",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 45},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
+
+func Test_AzurermStorageAccountInfrastructureEncryptionEnabledFix(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_storage_account_infrastructure_encryption_enabled")
+
+	tests := []struct {
+		Name    string
+		Content string
+	}{
+		{
+			Name: "infrastructure encryption disabled",
+			Content: `
+resource "azurerm_storage_account" "example" {
+  infrastructure_encryption_enabled = false
+}`,
+		},
+		{
+			Name: "infrastructure_encryption_enabled attribute missing",
+			Content: `
+resource "azurerm_storage_account" "example" {
+}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertChanges(t, map[string]string{}, runner.Changes())
+		})
+	}
+}
@@ -115,6 +115,86 @@ resource "azurerm_network_security_perimeter_association" "example2" {
 }`,
 			Expected: helper.Issues{},
 		},
+		{
+			Name: "storage account with for_each and NSP association indexed by each.key",
+			Content: `
+resource "azurerm_storage_account" "example" {
+  for_each = var.names
+}
+
+resource "azurerm_network_security_perimeter_association" "example" {
+  for_each    = var.names
+  resource_id = azurerm_storage_account.example[each.key].id
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "storage account with for_each and NSP association iterating over it",
+			Content: `
+resource "azurerm_storage_account" "example" {
+  for_each = toset(["a", "b"])
+}
+
+resource "azurerm_network_security_perimeter_association" "example" {
+  for_each    = azurerm_storage_account.example
+  resource_id = each.value.id
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "storage account with count and NSP association using a splat",
+			Content: `
+resource "azurerm_storage_account" "example" {
+  count = 2
+}
+
+resource "azurerm_network_security_perimeter_association" "example" {
+  count       = 2
+  resource_id = azurerm_storage_account.example[*].id[count.index]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "storage account with for_each without NSP association",
+			Content: `
+resource "azurerm_storage_account" "example" {
+  for_each = toset(["a", "b", "c"])
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAzurermStorageAccountNetworkSecurityPerimeterAssociation(),
+					Message: "Storage Account 'example' does not have an associated azurerm_network_security_perimeter_association",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 45},
+					},
+				},
+			},
+		},
+		{
+			Name: "NSP association iterating over another resource with each.value",
+			Content: `
+resource "azurerm_storage_account" "example" {
+  for_each = toset(["a"])
+}
+
+resource "azurerm_network_security_perimeter_association" "example" {
+  for_each    = var.ids
+  resource_id = each.value
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAzurermStorageAccountNetworkSecurityPerimeterAssociation(),
+					Message: "Storage Account 'example' does not have an associated azurerm_network_security_perimeter_association",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 45},
+					},
+				},
+			},
+		},
 		{
 			Name: "no storage accounts defined",
 			Content: `
@@ -1,8 +1,11 @@
 package rules
 
 import (
+	"fmt"
+
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
 
 	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
 )
@@ -45,6 +48,12 @@ func (r *AzurermStorageAccountPublicNetworkAccessEnabled) Link() string {
 
 // Check checks if transparent data encryption is enabled
 func (r *AzurermStorageAccountPublicNetworkAccessEnabled) Check(runner tflint.Runner) error {
+	expected, err := decodeDefaultActionRuleConfig(runner, r.Name(), []string{"Deny"})
+	if err != nil {
+		return err
+	}
+	defaultActionExpected := expected[0]
+
 	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
 		Attributes: []hclext.AttributeSchema{
 			{Name: r.attributeName},
@@ -65,7 +74,7 @@ func (r *AzurermStorageAccountPublicNetworkAccessEnabled) Check(runner tflint.Ru
 	}
 
 	for _, resource := range resources.Blocks {
-		// Check for network_rules block with default_action = "Deny"
+		// Check for network_rules block with the expected default_action
 		hasSecureNetworkRulesWithDeny := false
 		hasSecureNetworkRules := false
 		for _, block := range resource.Body.Blocks {
@@ -74,7 +83,7 @@ func (r *AzurermStorageAccountPublicNetworkAccessEnabled) Check(runner tflint.Ru
 				if defaultActionAttr, exists := block.Body.Attributes["default_action"]; exists {
 					var defaultAction string
 					if err := runner.EvaluateExpr(defaultActionAttr.Expr, &defaultAction, nil); err == nil {
-						if defaultAction == "Deny" {
+						if defaultAction == defaultActionExpected {
 							hasSecureNetworkRulesWithDeny = true
 							break
 						}
@@ -83,7 +92,7 @@ func (r *AzurermStorageAccountPublicNetworkAccessEnabled) Check(runner tflint.Ru
 			}
 		}
 
-		// If network rules with default_action = "Deny" exist, the configuration is secure
+		// If network rules with the expected default_action exist, the configuration is secure
 		if hasSecureNetworkRulesWithDeny {
 			continue
 		}
@@ -91,21 +100,27 @@ func (r *AzurermStorageAccountPublicNetworkAccessEnabled) Check(runner tflint.Ru
 		attribute, exists := resource.Body.Attributes[r.attributeName]
 		if !exists && !hasSecureNetworkRules {
 			// If the attribute does not exist and there are no secure network rules, emit an issue
-			runner.EmitIssue(
+			if err := runner.EmitIssueWithFix(
 				r,
-				"public_network_access_enabled is not defined and defaults to true, consider disabling it or adding network_rules with default_action = \"Deny\"",
+				fmt.Sprintf("public_network_access_enabled is not defined and defaults to true, consider disabling it or adding network_rules with default_action = %q", defaultActionExpected),
 				resource.DefRange,
-			)
+				fixInsertAttribute(runner, resource, r.attributeName, cty.False),
+			); err != nil {
+				return err
+			}
 			continue
 		}
 
-		err := runner.EvaluateExpr(attribute.Expr, func(val bool) error {
+		err = runner.EvaluateExpr(attribute.Expr, func(val bool) error {
 			if val {
-				runner.EmitIssue(
+				if err := runner.EmitIssueWithFix(
 					r,
-					"Consider changing public_network_access_enabled to false or add network_rules with default_action = \"Deny\"",
+					fmt.Sprintf("Consider changing public_network_access_enabled to false or add network_rules with default_action = %q", defaultActionExpected),
 					attribute.Expr.Range(),
-				)
+					fixReplaceLiteral(attribute, cty.False),
+				); err != nil {
+					return err
+				}
 			}
 			return nil
 		}, nil)
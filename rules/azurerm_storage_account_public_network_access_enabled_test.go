@@ -11,6 +11,7 @@ func Test_AzurermStorageAccountPublicNetworkAccessEnabled(t *testing.T) {
 	tests := []struct {
 		Name     string
 		Content  string
+		Config   string
 		Expected helper.Issues
 	}{
 		{
@@ -94,13 +95,29 @@ resource "azurerm_storage_account" "example" {
 				},
 			},
 		},
+		{
+			Name: "default action of the rule block",
+			Content: `
+resource "azurerm_storage_account" "example" {
+    public_network_access_enabled = true
+    network_rules {
+        default_action = "Allow"
+    }
+}`,
+			Config: `
+rule "azurerm_storage_account_public_network_access_enabled" {
+    enabled        = true
+    default_action = "Allow"
+}`,
+			Expected: helper.Issues{},
+		},
 	}
 
 	rule := NewAzurermStorageAccountPublicNetworkAccessEnabled()
 
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
-			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content, ".tflint.hcl": test.Config})
 
 			if err := rule.Check(runner); err != nil {
 				t.Fatalf("Unexpected error occurred: %s", err)
@@ -110,3 +127,51 @@ resource "azurerm_storage_account" "example" {
 		})
 	}
 }
+
+func Test_AzurermStorageAccountPublicNetworkAccessEnabledFix(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected string
+	}{
+		{
+			Name: "rewrite public_network_access_enabled",
+			Content: `
+resource "azurerm_storage_account" "example" {
+  public_network_access_enabled = true
+}`,
+			Expected: `
+resource "azurerm_storage_account" "example" {
+  public_network_access_enabled = false
+}`,
+		},
+		{
+			Name: "insert public_network_access_enabled",
+			Content: `
+resource "azurerm_storage_account" "example" {
+}`,
+			Expected: `
+resource "azurerm_storage_account" "example" {
+  public_network_access_enabled = false
+}`,
+		},
+	}
+
+	rule := NewAzurermStorageAccountPublicNetworkAccessEnabled()
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			expected := map[string]string{}
+			if test.Expected != "" {
+				expected["resource.tf"] = test.Expected
+			}
+			helper.AssertChanges(t, expected, runner.Changes())
+		})
+	}
+}
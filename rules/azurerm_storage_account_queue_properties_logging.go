@@ -0,0 +1,162 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+)
+
+// storageQueueLoggingOperations are the operations whose requests the queue logging must record
+var storageQueueLoggingOperations = []string{"read", "write", "delete"}
+
+// storageQueueLoggingSchema is the schema of the logging block of the queue properties
+var storageQueueLoggingSchema = hclext.BlockSchema{
+	Type: "logging",
+	Body: &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "read"}, {Name: "write"}, {Name: "delete"}},
+	},
+}
+
+// storageAccountQueueProperties sets the queue properties of a Storage Account outside of the account,
+// which replaces the queue_properties block from azurerm v4
+var storageAccountQueueProperties = association{
+	ResourceType: "azurerm_storage_account_queue_properties",
+	Path:         []string{"storage_account_id"},
+	Blocks:       []hclext.BlockSchema{storageQueueLoggingSchema},
+}
+
+// storageAccountKindsWithoutQueues are the account kinds that do not support the Queue service
+var storageAccountKindsWithoutQueues = []string{"BlobStorage", "BlockBlobStorage", "FileStorage"}
+
+// AzurermStorageAccountQueuePropertiesLogging checks that Storage Accounts log the read, write and delete requests of their queues
+type AzurermStorageAccountQueuePropertiesLogging struct {
+	tflint.DefaultRule
+
+	resourceType string
+}
+
+// NewAzurermStorageAccountQueuePropertiesLogging returns a new rule instance
+func NewAzurermStorageAccountQueuePropertiesLogging() *AzurermStorageAccountQueuePropertiesLogging {
+	return &AzurermStorageAccountQueuePropertiesLogging{
+		resourceType: "azurerm_storage_account",
+	}
+}
+
+// Name returns the rule name
+func (r *AzurermStorageAccountQueuePropertiesLogging) Name() string {
+	return "azurerm_storage_account_queue_properties_logging"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AzurermStorageAccountQueuePropertiesLogging) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AzurermStorageAccountQueuePropertiesLogging) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *AzurermStorageAccountQueuePropertiesLogging) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks that the logging block of queue_properties, or of an azurerm_storage_account_queue_properties,
+// logs read, write and delete requests
+func (r *AzurermStorageAccountQueuePropertiesLogging) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "account_kind"}},
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "queue_properties",
+				Body: &hclext.BodySchema{Blocks: []hclext.BlockSchema{storageQueueLoggingSchema}},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	properties, err := newAssociationIndex(runner, storageAccountQueueProperties, r.resourceType)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		if len(resource.Labels) < 2 {
+			continue
+		}
+		name := resource.Labels[1]
+
+		if kind, exists := resource.Body.Attributes["account_kind"]; exists {
+			value, known, err := evaluateValue(runner, kind.Expr)
+			if err != nil {
+				return err
+			}
+			if known && value.Type() == cty.String && slices.Contains(storageAccountKindsWithoutQueues, value.AsString()) {
+				continue
+			}
+		}
+
+		var loggings []*hclext.Block
+		for _, queueProperties := range resource.Body.Blocks.OfType("queue_properties") {
+			loggings = append(loggings, queueProperties.Body.Blocks.OfType("logging")...)
+		}
+		for _, queueProperties := range properties[name] {
+			loggings = append(loggings, queueProperties.Body.Blocks.OfType("logging")...)
+		}
+
+		if len(loggings) == 0 {
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Storage Account '%s' does not log queue requests, a logging block should be set in queue_properties or an azurerm_storage_account_queue_properties", name),
+				resource.DefRange,
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		for _, logging := range loggings {
+			if err := r.checkLogging(runner, logging); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkLogging reports the logging block when it does not log the requests of every operation
+func (r *AzurermStorageAccountQueuePropertiesLogging) checkLogging(runner tflint.Runner, logging *hclext.Block) error {
+	var missing []string
+	for _, operation := range storageQueueLoggingOperations {
+		attribute, exists := logging.Body.Attributes[operation]
+		if !exists {
+			missing = append(missing, operation)
+			continue
+		}
+		value, known, err := evaluateValue(runner, attribute.Expr)
+		if err != nil {
+			return err
+		}
+		if known && value.Type() == cty.Bool && value.False() {
+			missing = append(missing, operation)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	return runner.EmitIssue(
+		r,
+		fmt.Sprintf("logging does not record %s requests, read, write and delete should be true", strings.Join(missing, ", ")),
+		logging.DefRange,
+	)
+}
@@ -0,0 +1,155 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermStorageAccountQueuePropertiesLogging(t *testing.T) {
+	rule := NewAzurermStorageAccountQueuePropertiesLogging()
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "queue logging missing",
+			Content: `
+resource "azurerm_storage_account" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "Storage Account 'example' does not log queue requests, a logging block should be set in queue_properties or an azurerm_storage_account_queue_properties",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 45},
+					},
+				},
+			},
+		},
+		{
+			Name: "queue logging without writes",
+			Content: `
+resource "azurerm_storage_account" "example" {
+  queue_properties {
+    logging {
+      read    = true
+      write   = false
+      delete  = true
+      version = "1.0"
+    }
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "logging does not record write requests, read, write and delete should be true",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 5},
+						End:      hcl.Pos{Line: 4, Column: 12},
+					},
+				},
+			},
+		},
+		{
+			Name: "queue logging set by azurerm_storage_account_queue_properties",
+			Content: `
+resource "azurerm_storage_account" "example" {
+}
+
+resource "azurerm_storage_account_queue_properties" "example" {
+  storage_account_id = azurerm_storage_account.example.id
+
+  logging {
+    read    = true
+    write   = true
+    delete  = true
+    version = "1.0"
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "queue logging not supported by blob storage accounts",
+			Content: `
+resource "azurerm_storage_account" "example" {
+  account_kind = "BlockBlobStorage"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "queue logging with sensitive values",
+			Content: `
+resource "azurerm_storage_account" "example" {
+  account_kind = var.account_kind
+
+  queue_properties {
+    logging {
+      read    = true
+      write   = var.log_writes
+      delete  = true
+      version = "1.0"
+    }
+  }
+}
+
+variable "account_kind" {
+  default   = "StorageV2"
+  sensitive = true
+}
+
+variable "log_writes" {
+  default   = false
+  sensitive = true
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "logging does not record write requests, read, write and delete should be true",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 5},
+						End:      hcl.Pos{Line: 6, Column: 12},
+					},
+				},
+			},
+		},
+		{
+			Name: "queue logging of accounts created with for_each",
+			Content: `
+resource "azurerm_storage_account" "example" {
+  for_each     = var.accounts
+  account_kind = each.value.kind
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "Storage Account 'example' does not log queue requests, a logging block should be set in queue_properties or an azurerm_storage_account_queue_properties",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 45},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
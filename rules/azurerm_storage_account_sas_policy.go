@@ -0,0 +1,143 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+)
+
+// sasExpirationPeriodPattern matches the DD.HH:MM:SS format of the expiration_period of SAS policies
+var sasExpirationPeriodPattern = regexp.MustCompile(`^(\d+)\.(\d{2}):(\d{2}):(\d{2})$`)
+
+// AzurermStorageAccountSASPolicy checks that Storage Accounts limit the validity of shared access signatures
+type AzurermStorageAccountSASPolicy struct {
+	tflint.DefaultRule
+
+	resourceType string
+}
+
+// NewAzurermStorageAccountSASPolicy returns a new rule instance
+func NewAzurermStorageAccountSASPolicy() *AzurermStorageAccountSASPolicy {
+	return &AzurermStorageAccountSASPolicy{
+		resourceType: "azurerm_storage_account",
+	}
+}
+
+// Name returns the rule name
+func (r *AzurermStorageAccountSASPolicy) Name() string {
+	return "azurerm_storage_account_sas_policy"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AzurermStorageAccountSASPolicy) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AzurermStorageAccountSASPolicy) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *AzurermStorageAccountSASPolicy) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks that the sas_policy block sets an expiration_period of at most the configured maximum.
+// Accounts with shared_access_key_enabled = false are not checked, as they cannot sign shared access signatures with their keys.
+func (r *AzurermStorageAccountSASPolicy) Check(runner tflint.Runner) error {
+	config := sasPolicyRuleConfig{MaximumExpirationPeriod: DefaultSASMaximumExpirationPeriod}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+	maximum, ok := parseSASExpirationPeriod(config.MaximumExpirationPeriod)
+	if !ok {
+		return fmt.Errorf("maximum_expiration_period must be in the DD.HH:MM:SS format, got %q", config.MaximumExpirationPeriod)
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: storageAccountKeysDisabled.Attribute}},
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "sas_policy",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "expiration_period"}},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		keysDisabled, err := storageAccountKeysDisabled.Holds(runner, resource)
+		if err != nil {
+			return err
+		}
+		if keysDisabled {
+			continue
+		}
+
+		policies := resource.Body.Blocks.OfType("sas_policy")
+		if len(policies) == 0 {
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("sas_policy block is missing, shared access signatures should expire within %s", config.MaximumExpirationPeriod),
+				resource.DefRange,
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		attribute, exists := policies[0].Body.Attributes["expiration_period"]
+		if !exists {
+			continue
+		}
+		err = runner.EvaluateExpr(attribute.Expr, func(val string) error {
+			period, ok := parseSASExpirationPeriod(val)
+			if !ok || period <= maximum {
+				return nil
+			}
+			return runner.EmitIssueWithFix(
+				r,
+				fmt.Sprintf("expiration_period is %s, shared access signatures should expire within %s", val, config.MaximumExpirationPeriod),
+				attribute.Expr.Range(),
+				fixReplaceLiteral(attribute, cty.StringVal(config.MaximumExpirationPeriod)),
+			)
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseSASExpirationPeriod parses an expiration period in the DD.HH:MM:SS format
+func parseSASExpirationPeriod(period string) (time.Duration, bool) {
+	match := sasExpirationPeriodPattern.FindStringSubmatch(period)
+	if match == nil {
+		return 0, false
+	}
+	var parts [4]int
+	for i := range parts {
+		value, err := strconv.Atoi(match[i+1])
+		if err != nil {
+			return 0, false
+		}
+		parts[i] = value
+	}
+	if parts[1] > 23 || parts[2] > 59 || parts[3] > 59 {
+		return 0, false
+	}
+	return time.Duration(parts[0])*24*time.Hour + time.Duration(parts[1])*time.Hour + time.Duration(parts[2])*time.Minute + time.Duration(parts[3])*time.Second, true
+}
@@ -0,0 +1,119 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermStorageAccountSASPolicy(t *testing.T) {
+	rule := NewAzurermStorageAccountSASPolicy()
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "SAS policy missing",
+			Content: `
+resource "azurerm_storage_account" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "sas_policy block is missing, shared access signatures should expire within 0.01:00:00",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 45},
+					},
+				},
+			},
+		},
+		{
+			Name: "SAS policy not needed without shared access keys",
+			Content: `
+resource "azurerm_storage_account" "example" {
+  shared_access_key_enabled = false
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "SAS expiration period too long",
+			Content: `
+resource "azurerm_storage_account" "example" {
+  sas_policy {
+    expiration_period = "1.00:00:00"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "expiration_period is 1.00:00:00, shared access signatures should expire within 0.01:00:00",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 25},
+						End:      hcl.Pos{Line: 4, Column: 37},
+					},
+				},
+			},
+		},
+		{
+			Name: "SAS expiration period within the configured maximum",
+			Content: `
+resource "azurerm_storage_account" "example" {
+  sas_policy {
+    expiration_period = "1.00:00:00"
+  }
+}`,
+			Config: `
+rule "azurerm_storage_account_sas_policy" {
+  enabled                   = true
+  maximum_expiration_period = "7.00:00:00"
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content, ".tflint.hcl": test.Config})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
+
+func Test_ParseSASExpirationPeriod(t *testing.T) {
+	tests := []struct {
+		Period   string
+		Expected string
+		OK       bool
+	}{
+		{Period: "0.01:00:00", Expected: "1h0m0s", OK: true},
+		{Period: "7.00:00:00", Expected: "168h0m0s", OK: true},
+		{Period: "30.12:30:15", Expected: "732h30m15s", OK: true},
+		{Period: "1.24:00:00"},
+		{Period: "01:00:00"},
+		{Period: "P1D"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Period, func(t *testing.T) {
+			period, ok := parseSASExpirationPeriod(test.Period)
+			if ok != test.OK {
+				t.Fatalf("Expected ok to be %t, got %t", test.OK, ok)
+			}
+			if ok && period.String() != test.Expected {
+				t.Errorf("Expected %s, got %s", test.Expected, period)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ func Test_AzurermStorageAccountUnsecureTLS(t *testing.T) {
 	tests := []struct {
 		Name     string
 		Content  string
+		Config   string
 		Expected helper.Issues
 	}{
 		{
@@ -39,13 +40,42 @@ resource "azurerm_storage_account" "example" {
 }`,
 			Expected: helper.Issues{},
 		},
+		{
+			Name: "TLS version not in configured allowed_versions",
+			Content: `
+resource "azurerm_storage_account" "example" {
+    min_tls_version = "TLS1_2"
+}`,
+			Config: `
+rule "azurerm_storage_account_unsecure_tls" {
+    enabled          = true
+    allowed_versions = ["TLS1_3"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    NewAzurermStorageAccountUnsecureTLS(),
+					Message: "\"TLS1_2\" is an insecure value as min_tls_version",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start: hcl.Pos{
+							Line:   3,
+							Column: 23,
+						},
+						End: hcl.Pos{
+							Line:   3,
+							Column: 31,
+						},
+					},
+				},
+			},
+		},
 	}
 
 	rule := NewAzurermStorageAccountUnsecureTLS()
 
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
-			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content, ".tflint.hcl": test.Config})
 
 			if err := rule.Check(runner); err != nil {
 				t.Fatalf("Unexpected error occurred: %s", err)
@@ -54,4 +84,42 @@ resource "azurerm_storage_account" "example" {
 			helper.AssertIssues(t, test.Expected, runner.Issues)
 		})
 	}
-}
\ No newline at end of file
+}
+
+func Test_AzurermStorageAccountUnsecureTLSFix(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected string
+	}{
+		{
+			Name: "rewrite min_tls_version",
+			Content: `
+resource "azurerm_storage_account" "example" {
+  min_tls_version = "TLS1_0"
+}`,
+			Expected: `
+resource "azurerm_storage_account" "example" {
+  min_tls_version = "TLS1_2"
+}`,
+		},
+	}
+
+	rule := NewAzurermStorageAccountUnsecureTLS()
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			expected := map[string]string{}
+			if test.Expected != "" {
+				expected["resource.tf"] = test.Expected
+			}
+			helper.AssertChanges(t, expected, runner.Changes())
+		})
+	}
+}
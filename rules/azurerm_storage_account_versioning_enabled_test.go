@@ -0,0 +1,67 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermStorageAccountVersioningEnabled(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_storage_account_versioning_enabled")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "versioning disabled",
+			Content: `
+resource "azurerm_storage_account" "example" {
+  blob_properties {
+    versioning_enabled = false
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "versioning_enabled should be true to keep previous versions of blobs",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 26},
+						End:      hcl.Pos{Line: 4, Column: 31},
+					},
+				},
+			},
+		},
+		{
+			Name: "versioning not supported with a hierarchical namespace",
+			Content: `
+resource "azurerm_storage_account" "example" {
+  is_hns_enabled = true
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "versioning not supported by file storage accounts",
+			Content: `
+resource "azurerm_storage_account" "example" {
+  account_kind = "FileStorage"
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermStorageContainerContainerAccessType(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_storage_container_container_access_type")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "blob container readable anonymously",
+			Content: `
+resource "azurerm_storage_container" "example" {
+  container_access_type = "blob"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "container_access_type is blob, blobs can be read anonymously, should be private",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 27},
+						End:      hcl.Pos{Line: 3, Column: 33},
+					},
+				},
+			},
+		},
+		{
+			Name: "private container by default",
+			Content: `
+resource "azurerm_storage_container" "example" {
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -8,9 +8,12 @@ import (
 )
 
 func Test_AzurermWindowsFunctionAppFtpsState(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_windows_function_app_ftps_state")
+
 	tests := []struct {
 		Name     string
 		Content  string
+		Config   string
 		Expected helper.Issues
 	}{
 		{
@@ -23,18 +26,12 @@ resource "azurerm_windows_function_app" "example" {
 }`,
 			Expected: helper.Issues{
 				{
-					Rule:    NewAzurermWindowsFunctionAppFtpsState(),
+					Rule:    rule,
 					Message: "ftps_state is set to FtpsOnly, should be set to Disabled",
 					Range: hcl.Range{
 						Filename: "resource.tf",
-						Start: hcl.Pos{
-							Line:   4,
-							Column: 22,
-						},
-						End: hcl.Pos{
-							Line:   4,
-							Column: 32,
-						},
+						Start:    hcl.Pos{Line: 4, Column: 22},
+						End:      hcl.Pos{Line: 4, Column: 32},
 					},
 				},
 			},
@@ -68,18 +65,12 @@ resource "azurerm_windows_function_app" "example" {
 }`,
 			Expected: helper.Issues{
 				{
-					Rule:    NewAzurermWindowsFunctionAppFtpsState(),
+					Rule:    rule,
 					Message: "ftps_state is missing in site_config, should be set to Disabled",
 					Range: hcl.Range{
 						Filename: "resource.tf",
-						Start: hcl.Pos{
-							Line:   3,
-							Column: 5,
-						},
-						End: hcl.Pos{
-							Line:   3,
-							Column: 16,
-						},
+						Start:    hcl.Pos{Line: 3, Column: 5},
+						End:      hcl.Pos{Line: 3, Column: 16},
 					},
 				},
 			},
@@ -91,29 +82,61 @@ resource "azurerm_windows_function_app" "example" {
 }`,
 			Expected: helper.Issues{
 				{
-					Rule:    NewAzurermWindowsFunctionAppFtpsState(),
+					Rule:    rule,
 					Message: "site_config block is missing, ftps_state should be set to Disabled",
 					Range: hcl.Range{
 						Filename: "resource.tf",
-						Start: hcl.Pos{
-							Line:   2,
-							Column: 1,
-						},
-						End: hcl.Pos{
-							Line:   2,
-							Column: 50,
-						},
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 50},
+					},
+				},
+			},
+		},
+		{
+			Name: "ftps_state in configured allowed_states",
+			Content: `
+resource "azurerm_windows_function_app" "example" {
+    site_config {
+        ftps_state = "FtpsOnly"
+    }
+}`,
+			Config: `
+rule "azurerm_windows_function_app_ftps_state" {
+    enabled        = true
+    allowed_states = ["Disabled", "FtpsOnly"]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "ftps_state not in configured allowed_states",
+			Content: `
+resource "azurerm_windows_function_app" "example" {
+    site_config {
+        ftps_state = "AllAllowed"
+    }
+}`,
+			Config: `
+rule "azurerm_windows_function_app_ftps_state" {
+    enabled        = true
+    allowed_states = ["Disabled", "FtpsOnly"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "ftps_state is set to AllAllowed, should be set to Disabled or FtpsOnly",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 22},
+						End:      hcl.Pos{Line: 4, Column: 34},
 					},
 				},
 			},
 		},
 	}
 
-	rule := NewAzurermWindowsFunctionAppFtpsState()
-
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
-			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content, ".tflint.hcl": test.Config})
 
 			if err := rule.Check(runner); err != nil {
 				t.Fatalf("Unexpected error occurred: %s", err)
@@ -122,4 +145,4 @@ resource "azurerm_windows_function_app" "example" {
 			helper.AssertIssues(t, test.Expected, runner.Issues)
 		})
 	}
-}
\ No newline at end of file
+}
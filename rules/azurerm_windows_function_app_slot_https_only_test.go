@@ -8,6 +8,8 @@ import (
 )
 
 func Test_AzurermWindowsFunctionAppSlotHTTPSOnly(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_windows_function_app_slot_https_only")
+
 	tests := []struct {
 		Name     string
 		Content  string
@@ -21,7 +23,7 @@ resource "azurerm_windows_function_app_slot" "example" {
 }`,
 			Expected: helper.Issues{
 				{
-					Rule:    NewAzurermWindowsFunctionAppSlotHTTPSOnly(),
+					Rule:    rule,
 					Message: "https_only should be true",
 					Range: hcl.Range{
 						Filename: "resource.tf",
@@ -38,7 +40,7 @@ resource "azurerm_windows_function_app_slot" "example" {
 }`,
 			Expected: helper.Issues{
 				{
-					Rule:    NewAzurermWindowsFunctionAppSlotHTTPSOnly(),
+					Rule:    rule,
 					Message: "https_only is not defined and should be true",
 					Range: hcl.Range{
 						Filename: "resource.tf",
@@ -58,8 +60,6 @@ resource "azurerm_windows_function_app_slot" "example" {
 		},
 	}
 
-	rule := NewAzurermWindowsFunctionAppSlotHTTPSOnly()
-
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
 			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
@@ -72,3 +72,37 @@ resource "azurerm_windows_function_app_slot" "example" {
 		})
 	}
 }
+
+func Test_AzurermWindowsFunctionAppSlotHTTPSOnlyFix(t *testing.T) {
+	rule := findAttributeRule(t, "azurerm_windows_function_app_slot_https_only")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected string
+	}{
+		{
+			Name: "rewrite https_only",
+			Content: `
+resource "azurerm_windows_function_app_slot" "example" {
+  https_only = false
+}`,
+			Expected: `
+resource "azurerm_windows_function_app_slot" "example" {
+  https_only = true
+}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertChanges(t, map[string]string{"resource.tf": test.Expected}, runner.Changes())
+		})
+	}
+}
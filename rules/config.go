@@ -0,0 +1,207 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// DefaultMinimumTLSVersion is the minimum TLS version enforced when the plugin block does not set one
+const DefaultMinimumTLSVersion = "1.2"
+
+// DefaultProfile is the security profile used when the plugin block does not set one
+const DefaultProfile = "baseline"
+
+// Config is the plugin-wide configuration declared in the `plugin "azurerm-security"` block
+type Config struct {
+	MinimumTLSVersion string          `hclext:"minimum_tls_version,optional"`
+	Profile           string          `hclext:"profile,optional"`
+	Excludes          []ExcludeConfig `hclext:"exclude,block"`
+}
+
+// ExcludeConfig excludes resources of a given type from every rule.
+// When Names is empty, all resources of the type are excluded.
+type ExcludeConfig struct {
+	ResourceType string   `hclext:"resource_type,label"`
+	Names        []string `hclext:"names,optional"`
+}
+
+// ConfigurableRule is implemented by rules that react to the plugin-wide configuration
+type ConfigurableRule interface {
+	tflint.Rule
+
+	ApplyConfig(config *Config)
+}
+
+// EnabledRulesAwareRule is implemented by rules whose checks depend on whether other rules are enabled
+type EnabledRulesAwareRule interface {
+	tflint.Rule
+
+	ApplyEnabledRules(enabled []tflint.Rule)
+}
+
+// NewConfig returns the plugin-wide configuration with its defaults applied
+func NewConfig() *Config {
+	config := &Config{}
+	config.SetDefaults()
+	return config
+}
+
+// SetDefaults fills the settings left unset in the plugin block.
+// The minimum TLS version defaults to the one of the selected profile.
+func (c *Config) SetDefaults() {
+	if c.Profile == "" {
+		c.Profile = DefaultProfile
+	}
+	if c.MinimumTLSVersion == "" {
+		c.MinimumTLSVersion = DefaultMinimumTLSVersion
+		if profile, ok := LookupProfile(c.Profile); ok {
+			c.MinimumTLSVersion = profile.TLSVersion()
+		}
+	}
+}
+
+// Validate checks that the configured values are supported
+func (c *Config) Validate() error {
+	if !slices.Contains(tlsVersions, c.MinimumTLSVersion) {
+		return fmt.Errorf("minimum_tls_version must be one of %s, got %q", strings.Join(tlsVersions, ", "), c.MinimumTLSVersion)
+	}
+	if _, ok := LookupProfile(c.Profile); !ok {
+		names := make([]string, len(Profiles))
+		for i, profile := range Profiles {
+			names[i] = profile.Name
+		}
+		return fmt.Errorf("profile must be one of %s, got %q", strings.Join(names, ", "), c.Profile)
+	}
+	for _, exclude := range c.Excludes {
+		if !strings.HasPrefix(exclude.ResourceType, "azurerm_") {
+			return fmt.Errorf("exclude block label must be an azurerm resource type, got %q", exclude.ResourceType)
+		}
+	}
+	return nil
+}
+
+// IsExcluded returns whether the resource with the given type and name is excluded
+func (c *Config) IsExcluded(resourceType string, name string) bool {
+	for _, exclude := range c.Excludes {
+		if exclude.ResourceType != resourceType {
+			continue
+		}
+		if len(exclude.Names) == 0 || slices.Contains(exclude.Names, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// tlsVersions are the TLS versions accepted by the minimum_tls_version plugin setting, from oldest to newest
+var tlsVersions = []string{"1.0", "1.1", "1.2", "1.3"}
+
+// Values used by the different Azure resources for their TLS version attributes, from oldest to newest
+var (
+	appServiceTLSVersions = []string{"1.0", "1.1", "1.2", "1.3"}
+	cosmosdbTLSVersions   = []string{"Tls", "Tls11", "Tls12"}
+	eventhubTLSVersions   = []string{"TLS1_0", "TLS1_1", "TLS1_2", "TLS1_3"}
+	mssqlTLSVersions      = []string{"1.0", "1.1", "1.2", "1.3"}
+	redisTLSVersions      = []string{"1.0", "1.1", "1.2"}
+	servicebusTLSVersions = []string{"1.0", "1.1", "1.2"}
+	storageTLSVersions    = []string{"TLS1_0", "TLS1_1", "TLS1_2", "TLS1_3"}
+)
+
+// tlsVersionsAtLeast returns the values of known that are at least the given minimum TLS version.
+// When the minimum is newer than anything the resource supports, the newest known value is returned.
+func tlsVersionsAtLeast(minimum string, known []string) []string {
+	normalize := func(version string) string {
+		return strings.Map(func(r rune) rune {
+			if r >= '0' && r <= '9' {
+				return r
+			}
+			return -1
+		}, version)
+	}
+
+	var versions []string
+	for _, version := range known {
+		if normalize(version) >= normalize(minimum) {
+			versions = append(versions, version)
+		}
+	}
+	if len(versions) == 0 {
+		return known[len(known)-1:]
+	}
+	return versions
+}
+
+// tlsVersionRuleConfig is the `rule` block of the rules that check a TLS version against a list of allowed values
+type tlsVersionRuleConfig struct {
+	AllowedVersions []string `hclext:"allowed_versions,optional"`
+}
+
+// ftpsStateRuleConfig is the `rule` block of the ftps_state rules
+type ftpsStateRuleConfig struct {
+	AllowedStates []string `hclext:"allowed_states,optional"`
+}
+
+// defaultActionRuleConfig is the `rule` block of the rules that require a network default action
+type defaultActionRuleConfig struct {
+	DefaultAction string `hclext:"default_action,optional"`
+}
+
+// privateEndpointRuleConfig is the `rule` block of the private endpoint rules
+type privateEndpointRuleConfig struct {
+	SubresourceNames []string `hclext:"subresource_names,optional"`
+}
+
+// diagnosticSettingRuleConfig is the `rule` block of the diagnostic setting rules
+type diagnosticSettingRuleConfig struct {
+	Categories []string `hclext:"categories,optional"`
+}
+
+// DefaultMaxPrefixSize is the widest public range, as a prefix length, allowed by the firewall and IP rules
+const DefaultMaxPrefixSize = 16
+
+// ipRangeRuleConfig is the `rule` block of the firewall and IP rules
+type ipRangeRuleConfig struct {
+	AllowedRanges      []string `hclext:"allowed_ranges,optional"`
+	MaxPrefixSize      int      `hclext:"max_prefix_size,optional"`
+	AllowAzureServices bool     `hclext:"allow_azure_services,optional"`
+}
+
+// DefaultAuditRetentionDays is the minimum retention of the SQL Server auditing policies
+const DefaultAuditRetentionDays = 90
+
+// DefaultShortTermRetentionDays is the minimum point-in-time restore retention of the SQL databases,
+// and the retention Azure applies when short_term_retention_policy is not set
+const DefaultShortTermRetentionDays = 7
+
+// DefaultSoftDeleteRetentionDays is the minimum retention of deleted blobs and containers of the Storage Accounts,
+// and the retention Azure applies when days is not set in a soft delete policy
+const DefaultSoftDeleteRetentionDays = 7
+
+// retentionRuleConfig is the `rule` block of the rules that require a minimum retention
+type retentionRuleConfig struct {
+	MinimumRetentionDays int `hclext:"minimum_retention_days,optional"`
+}
+
+// decodeRetentionRuleConfig decodes the minimum_retention_days of a retention rule
+func decodeRetentionRuleConfig(runner tflint.Runner, ruleName string, defaultDays int) (int, error) {
+	config := retentionRuleConfig{MinimumRetentionDays: defaultDays}
+	if err := runner.DecodeRuleConfig(ruleName, &config); err != nil {
+		return 0, err
+	}
+	if config.MinimumRetentionDays < 1 {
+		return 0, fmt.Errorf("minimum_retention_days must be at least 1, got %d", config.MinimumRetentionDays)
+	}
+	return config.MinimumRetentionDays, nil
+}
+
+// DefaultSASMaximumExpirationPeriod is the longest validity of shared access signatures accepted in the SAS policy
+// of the Storage Accounts, in the DD.HH:MM:SS format of expiration_period
+const DefaultSASMaximumExpirationPeriod = "0.01:00:00"
+
+// sasPolicyRuleConfig is the `rule` block of the Storage Account SAS policy rule
+type sasPolicyRuleConfig struct {
+	MaximumExpirationPeriod string `hclext:"maximum_expiration_period,optional"`
+}
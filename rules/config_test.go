@@ -0,0 +1,102 @@
+package rules
+
+import (
+	"slices"
+	"testing"
+)
+
+func Test_TLSVersionsAtLeast(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Minimum  string
+		Known    []string
+		Expected []string
+	}{
+		{
+			Name:     "dotted versions",
+			Minimum:  "1.2",
+			Known:    appServiceTLSVersions,
+			Expected: []string{"1.2", "1.3"},
+		},
+		{
+			Name:     "enum versions",
+			Minimum:  "1.1",
+			Known:    storageTLSVersions,
+			Expected: []string{"TLS1_1", "TLS1_2", "TLS1_3"},
+		},
+		{
+			Name:     "minimum newer than supported",
+			Minimum:  "1.3",
+			Known:    redisTLSVersions,
+			Expected: []string{"1.2"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			actual := tlsVersionsAtLeast(test.Minimum, test.Known)
+			if !slices.Equal(actual, test.Expected) {
+				t.Errorf("Expected %v, got %v", test.Expected, actual)
+			}
+		})
+	}
+}
+
+func Test_ConfigIsExcluded(t *testing.T) {
+	config := NewConfig()
+	config.Excludes = []ExcludeConfig{
+		{ResourceType: "azurerm_storage_account", Names: []string{"legacy"}},
+		{ResourceType: "azurerm_redis_cache"},
+	}
+
+	tests := []struct {
+		ResourceType string
+		Name         string
+		Expected     bool
+	}{
+		{ResourceType: "azurerm_storage_account", Name: "legacy", Expected: true},
+		{ResourceType: "azurerm_storage_account", Name: "example", Expected: false},
+		{ResourceType: "azurerm_redis_cache", Name: "example", Expected: true},
+		{ResourceType: "azurerm_key_vault", Name: "legacy", Expected: false},
+	}
+
+	for _, test := range tests {
+		if actual := config.IsExcluded(test.ResourceType, test.Name); actual != test.Expected {
+			t.Errorf("IsExcluded(%s, %s) = %t, expected %t", test.ResourceType, test.Name, actual, test.Expected)
+		}
+	}
+}
+
+func Test_ConfigSetDefaults(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Config   Config
+		Expected Config
+	}{
+		{
+			Name:     "defaults",
+			Config:   Config{},
+			Expected: Config{Profile: "baseline", MinimumTLSVersion: "1.2"},
+		},
+		{
+			Name:     "minimum TLS version of the profile",
+			Config:   Config{Profile: "regulated"},
+			Expected: Config{Profile: "regulated", MinimumTLSVersion: "1.3"},
+		},
+		{
+			Name:     "explicit minimum TLS version",
+			Config:   Config{Profile: "strict", MinimumTLSVersion: "1.2"},
+			Expected: Config{Profile: "strict", MinimumTLSVersion: "1.2"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			config := test.Config
+			config.SetDefaults()
+			if config.Profile != test.Expected.Profile || config.MinimumTLSVersion != test.Expected.MinimumTLSVersion {
+				t.Errorf("Expected %s/%s, got %s/%s", test.Expected.Profile, test.Expected.MinimumTLSVersion, config.Profile, config.MinimumTLSVersion)
+			}
+		})
+	}
+}
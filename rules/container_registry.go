@@ -0,0 +1,245 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+)
+
+// containerRegistryResourceTypes are the resource types of the Container Registry rules
+var containerRegistryResourceTypes = []string{"azurerm_container_registry"}
+
+// premiumContainerRegistry restricts rules to the Premium SKU, the only one supporting the policies and private endpoints
+var premiumContainerRegistry = &AttributeRuleCondition{Attribute: "sku", Values: []string{"Premium"}}
+
+// containerRegistryRuleSpecs declares the attribute rules of the Container Registries
+var containerRegistryRuleSpecs = []*AttributeRuleSpec{
+	{
+		Name:          "admin_enabled",
+		ResourceTypes: containerRegistryResourceTypes,
+		AttributePath: []string{"admin_enabled"},
+		Type:          cty.Bool,
+		Expected:      []string{"false"},
+		Enabled:       true,
+		Severity:      tflint.WARNING,
+		Messages: AttributeRuleMessages{
+			MissingAttribute: "admin_enabled is not defined and should be false",
+			InvalidValue:     "admin_enabled should be false, the admin account shares a single set of credentials with full access",
+		},
+	},
+	{
+		Name:          "anonymous_pull_enabled",
+		ResourceTypes: containerRegistryResourceTypes,
+		AttributePath: []string{"anonymous_pull_enabled"},
+		Type:          cty.Bool,
+		Expected:      []string{"false"},
+		Enabled:       true,
+		Severity:      tflint.WARNING,
+		Messages: AttributeRuleMessages{
+			MissingAttribute: "anonymous_pull_enabled is not defined and should be false",
+			InvalidValue:     "anonymous_pull_enabled should be false",
+		},
+	},
+	{
+		Name:          "export_policy_enabled",
+		ResourceTypes: containerRegistryResourceTypes,
+		AttributePath: []string{"export_policy_enabled"},
+		Type:          cty.Bool,
+		Expected:      []string{"false"},
+		Enabled:       true,
+		Severity:      tflint.NOTICE,
+		Messages: AttributeRuleMessages{
+			MissingAttribute: "export_policy_enabled is not defined and defaults to true, should be false for registries without public network access",
+			InvalidValue:     "export_policy_enabled should be false for registries without public network access",
+		},
+		// The export policy can only be disabled once public network access is
+		Condition: &AttributeRuleCondition{Attribute: "public_network_access_enabled", Values: []string{"false"}},
+	},
+	{
+		Name:          "quarantine_policy_enabled",
+		ResourceTypes: containerRegistryResourceTypes,
+		AttributePath: []string{"quarantine_policy_enabled"},
+		Type:          cty.Bool,
+		Expected:      []string{"true"},
+		Enabled:       true,
+		Severity:      tflint.NOTICE,
+		Messages: AttributeRuleMessages{
+			MissingAttribute: "quarantine_policy_enabled is not defined and should be true for Premium registries",
+			InvalidValue:     "quarantine_policy_enabled should be true for Premium registries",
+		},
+		Condition: premiumContainerRegistry,
+	},
+}
+
+// containerRegistryPolicySpec declares a policy of Premium registries, configured by a block with azurerm v3
+// and by a top-level attribute with azurerm v4
+type containerRegistryPolicySpec struct {
+	// Name is the rule name without the resource type prefix, and the block type of azurerm v3
+	Name string
+	// Title is the name of the feature enabled by the policy used in issue messages
+	Title string
+	// Attribute is the azurerm v4 attribute, either a bool or a number of days
+	Attribute string
+}
+
+// containerRegistryPolicySpecs are the policies checked by the Container Registry policy rules
+var containerRegistryPolicySpecs = []containerRegistryPolicySpec{
+	{Name: "retention_policy", Title: "the retention of untagged manifests", Attribute: "retention_policy_in_days"},
+	{Name: "trust_policy", Title: "content trust", Attribute: "trust_policy_enabled"},
+}
+
+// ContainerRegistryPolicy checks that a policy is enabled on Premium Container Registries
+type ContainerRegistryPolicy struct {
+	tflint.DefaultRule
+
+	resourceType string
+	spec         containerRegistryPolicySpec
+}
+
+// NewContainerRegistryPolicyRules returns a rule instance for every Container Registry policy
+func NewContainerRegistryPolicyRules() []tflint.Rule {
+	var rules []tflint.Rule
+	for _, spec := range containerRegistryPolicySpecs {
+		rules = append(rules, &ContainerRegistryPolicy{
+			resourceType: "azurerm_container_registry",
+			spec:         spec,
+		})
+	}
+	return rules
+}
+
+// Name returns the rule name
+func (r *ContainerRegistryPolicy) Name() string {
+	return r.resourceType + "_" + r.spec.Name
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *ContainerRegistryPolicy) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *ContainerRegistryPolicy) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *ContainerRegistryPolicy) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks that Premium registries enable the policy, under the names of the azurerm major version in use
+func (r *ContainerRegistryPolicy) Check(runner tflint.Runner) error {
+	majorVersion, err := azurermMajorVersion(runner)
+	if err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: premiumContainerRegistry.Attribute},
+			{Name: r.spec.Attribute},
+		},
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: r.spec.Name,
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "enabled"}},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		premium, err := premiumContainerRegistry.Holds(runner, resource)
+		if err != nil {
+			return err
+		}
+		if !premium {
+			continue
+		}
+
+		if majorVersion < 4 {
+			err = r.checkBlock(runner, resource)
+		} else {
+			err = r.checkAttribute(runner, resource)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkBlock checks the azurerm v3 policy block, whose enabled attribute must be true
+func (r *ContainerRegistryPolicy) checkBlock(runner tflint.Runner, resource *hclext.Block) error {
+	blocks := resource.Body.Blocks.OfType(r.spec.Name)
+	if len(blocks) == 0 {
+		return runner.EmitIssue(
+			r,
+			fmt.Sprintf("%s block is missing, %s should be enabled on Premium registries", r.spec.Name, r.spec.Title),
+			resource.DefRange,
+		)
+	}
+
+	attribute, exists := blocks[0].Body.Attributes["enabled"]
+	if !exists {
+		return runner.EmitIssue(
+			r,
+			fmt.Sprintf("enabled is missing in %s, %s should be enabled on Premium registries", r.spec.Name, r.spec.Title),
+			blocks[0].DefRange,
+		)
+	}
+	return runner.EvaluateExpr(attribute.Expr, func(enabled bool) error {
+		if enabled {
+			return nil
+		}
+		return runner.EmitIssue(
+			r,
+			fmt.Sprintf("enabled should be true in %s, %s should be enabled on Premium registries", r.spec.Name, r.spec.Title),
+			attribute.Expr.Range(),
+		)
+	}, nil)
+}
+
+// checkAttribute checks the azurerm v4 policy attribute, which must be true or a positive number of days
+func (r *ContainerRegistryPolicy) checkAttribute(runner tflint.Runner, resource *hclext.Block) error {
+	attribute, exists := resource.Body.Attributes[r.spec.Attribute]
+	if !exists {
+		return runner.EmitIssue(
+			r,
+			fmt.Sprintf("%s is not defined, %s should be enabled on Premium registries", r.spec.Attribute, r.spec.Title),
+			resource.DefRange,
+		)
+	}
+	return evaluateUnmarked(runner, attribute.Expr, func(val cty.Value) error {
+		if !val.IsWhollyKnown() || val.IsNull() {
+			return nil
+		}
+		switch val.Type() {
+		case cty.Bool:
+			if val.True() {
+				return nil
+			}
+		case cty.Number:
+			if val.GreaterThan(cty.Zero).True() {
+				return nil
+			}
+		default:
+			return nil
+		}
+		return runner.EmitIssue(
+			r,
+			fmt.Sprintf("%s does not enable %s, which should be enabled on Premium registries", r.spec.Attribute, r.spec.Title),
+			attribute.Expr.Range(),
+		)
+	})
+}
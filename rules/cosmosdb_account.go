@@ -0,0 +1,68 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// cosmosdbAccountResourceTypes are the resource types of the Cosmos DB account rules
+var cosmosdbAccountResourceTypes = []string{"azurerm_cosmosdb_account"}
+
+// cosmosdbAccountRuleSpecs declares the attribute rules of the Cosmos DB accounts
+var cosmosdbAccountRuleSpecs = []*AttributeRuleSpec{
+	{
+		Name:          "local_authentication_disabled",
+		ResourceTypes: cosmosdbAccountResourceTypes,
+		AttributePath: []string{"local_authentication_disabled"},
+		Type:          cty.Bool,
+		Expected:      []string{"true"},
+		Enabled:       true,
+		Severity:      tflint.WARNING,
+		Messages: AttributeRuleMessages{
+			MissingAttribute: "local_authentication_disabled is not defined and should be true",
+			InvalidValue:     "local_authentication_disabled should be true",
+		},
+	},
+	{
+		Name:          "access_key_metadata_writes_enabled",
+		ResourceTypes: cosmosdbAccountResourceTypes,
+		AttributePath: []string{"access_key_metadata_writes_enabled"},
+		Type:          cty.Bool,
+		Expected:      []string{"false"},
+		Enabled:       true,
+		Severity:      tflint.WARNING,
+		Messages: AttributeRuleMessages{
+			MissingAttribute: "access_key_metadata_writes_enabled is not defined and defaults to true, should be false",
+			InvalidValue:     "access_key_metadata_writes_enabled should be false",
+		},
+	},
+	{
+		Name:          "minimal_tls_version",
+		ResourceTypes: cosmosdbAccountResourceTypes,
+		AttributePath: []string{"minimal_tls_version"},
+		Type:          cty.String,
+		Expected:      tlsVersionsAtLeast(DefaultMinimumTLSVersion, cosmosdbTLSVersions),
+		Enabled:       true,
+		Severity:      tflint.WARNING,
+		Messages: AttributeRuleMessages{
+			MissingAttribute: "minimal_tls_version is not defined, should be set to {expected}",
+			InvalidValue:     "minimal_tls_version is set to {value}, should be {expected}",
+		},
+		TLSVersions:  cosmosdbTLSVersions,
+		DecodeConfig: decodeTLSVersionRuleConfig,
+	},
+	{
+		Name:          "backup_type",
+		ResourceTypes: cosmosdbAccountResourceTypes,
+		AttributePath: []string{"backup", "type"},
+		Type:          cty.String,
+		Expected:      []string{"Continuous"},
+		Enabled:       true,
+		Severity:      tflint.NOTICE,
+		Messages: AttributeRuleMessages{
+			MissingBlock:     "backup block is missing and defaults to periodic backups, type should be set to {expected}",
+			MissingAttribute: "type is missing in backup, should be set to {expected}",
+			InvalidValue:     "backup type is set to {value}, should be set to {expected} for point-in-time restore",
+		},
+	},
+}
@@ -0,0 +1,336 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+)
+
+// customerManagedKeySpec declares a resource type whose data must be encrypted with a customer-managed key
+type customerManagedKeySpec struct {
+	ResourceType string
+	// Title is the name of the resource type used in issue messages
+	Title string
+	// AttributePath lists the nested block types followed by the attribute holding the key ID.
+	// It is empty for resource types whose key can only be set by the KeyResource.
+	AttributePath []string
+	// KeyResource is a resource type setting the key outside of the resource, such as azurerm_storage_account_customer_managed_key
+	KeyResource *customerManagedKeyResource
+	// Condition restricts the rule to the resources supporting customer-managed keys, such as those of a SKU
+	Condition *AttributeRuleCondition
+}
+
+// customerManagedKeyResource is a resource type setting the customer-managed key of another resource
+type customerManagedKeyResource struct {
+	ResourceType string
+	// Attribute holds the ID of the encrypted resource
+	Attribute string
+	// KeyAttributes reference the key, the data is encrypted with a service-managed key when none of them is set
+	KeyAttributes []string
+}
+
+// association returns the association of the key resources to the encrypted resources
+func (k *customerManagedKeyResource) association() association {
+	return association{ResourceType: k.ResourceType, Path: []string{k.Attribute}, Attributes: k.KeyAttributes}
+}
+
+// customerManagedKeySpecs are the resource types checked by the customer-managed key rules
+var customerManagedKeySpecs = []customerManagedKeySpec{
+	{ResourceType: "azurerm_container_registry", Title: "Container Registry", AttributePath: []string{"encryption", "key_vault_key_id"}, Condition: premiumContainerRegistry},
+	{ResourceType: "azurerm_cosmosdb_account", Title: "Cosmos DB Account", AttributePath: []string{"key_vault_key_id"}},
+	{
+		ResourceType: "azurerm_eventhub_namespace",
+		Title:        "Event Hub Namespace",
+		KeyResource:  &customerManagedKeyResource{ResourceType: "azurerm_eventhub_namespace_customer_managed_key", Attribute: "eventhub_namespace_id", KeyAttributes: []string{"key_vault_key_ids"}},
+		Condition:    premiumEventhubNamespace,
+	},
+	// The OS and data disks of the nodes are encrypted with the keys of the disk encryption set
+	{ResourceType: "azurerm_kubernetes_cluster", Title: "Kubernetes Cluster", AttributePath: []string{"disk_encryption_set_id"}},
+	{ResourceType: "azurerm_managed_disk", Title: "Managed Disk", AttributePath: []string{"disk_encryption_set_id"}},
+	{
+		ResourceType: "azurerm_mssql_server",
+		Title:        "SQL Server",
+		KeyResource:  &customerManagedKeyResource{ResourceType: "azurerm_mssql_server_transparent_data_encryption", Attribute: "server_id", KeyAttributes: []string{"key_vault_key_id", "managed_hsm_key_id"}},
+	},
+	{ResourceType: "azurerm_mysql_flexible_server", Title: "MySQL Flexible Server", AttributePath: []string{"customer_managed_key", "key_vault_key_id"}},
+	{ResourceType: "azurerm_postgresql_flexible_server", Title: "PostgreSQL Flexible Server", AttributePath: []string{"customer_managed_key", "key_vault_key_id"}},
+	{
+		ResourceType:  "azurerm_servicebus_namespace",
+		Title:         "Service Bus Namespace",
+		AttributePath: []string{"customer_managed_key", "key_vault_key_id"},
+		KeyResource:   &customerManagedKeyResource{ResourceType: "azurerm_servicebus_namespace_customer_managed_key", Attribute: "namespace_id", KeyAttributes: []string{"key_vault_key_id"}},
+		Condition:     premiumServicebusNamespace,
+	},
+	{
+		ResourceType:  "azurerm_storage_account",
+		Title:         "Storage Account",
+		AttributePath: []string{"customer_managed_key", "key_vault_key_id"},
+		KeyResource:   &customerManagedKeyResource{ResourceType: "azurerm_storage_account_customer_managed_key", Attribute: "storage_account_id", KeyAttributes: []string{"key_vault_key_id", "key_name", "managed_hsm_key_id"}},
+	},
+}
+
+// keyVaultKeyReferenceAttributes are the attributes of azurerm_key_vault_key through which a key is referenced
+var keyVaultKeyReferenceAttributes = []string{"id", "versionless_id", "name"}
+
+// CustomerManagedKey checks that resources are encrypted with a customer-managed key
+type CustomerManagedKey struct {
+	tflint.DefaultRule
+
+	resourceType string
+	spec         customerManagedKeySpec
+}
+
+// NewCustomerManagedKeyRules returns a rule instance for every resource type that must be encrypted with a customer-managed key
+func NewCustomerManagedKeyRules() []tflint.Rule {
+	var rules []tflint.Rule
+	for _, spec := range customerManagedKeySpecs {
+		rules = append(rules, &CustomerManagedKey{
+			resourceType: spec.ResourceType,
+			spec:         spec,
+		})
+	}
+	return rules
+}
+
+// Name returns the rule name
+func (r *CustomerManagedKey) Name() string {
+	return r.resourceType + "_customer_managed_key"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *CustomerManagedKey) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *CustomerManagedKey) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *CustomerManagedKey) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks that the attribute holding the key ID is set, in the nested blocks of the spec, or that a key resource
+// of the spec sets the key. Keys referenced as azurerm_key_vault_key resources of the module must have a rotation policy.
+func (r *CustomerManagedKey) Check(runner tflint.Runner) error {
+	path := r.spec.AttributePath
+
+	schema := &hclext.BodySchema{}
+	if len(path) > 0 {
+		schema.Attributes = []hclext.AttributeSchema{{Name: path[len(path)-1]}}
+		for i := len(path) - 2; i >= 0; i-- {
+			schema = &hclext.BodySchema{Blocks: []hclext.BlockSchema{{Type: path[i], Body: schema}}}
+		}
+	}
+	if r.spec.Condition != nil {
+		schema.Attributes = append(schema.Attributes, hclext.AttributeSchema{Name: r.spec.Condition.Attribute})
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, schema, nil)
+	if err != nil {
+		return err
+	}
+
+	keyResources := associationIndex{}
+	if r.spec.KeyResource != nil {
+		keyResources, err = newAssociationIndex(runner, r.spec.KeyResource.association(), r.resourceType)
+		if err != nil {
+			return err
+		}
+	}
+
+	rotation, err := newKeyRotationChecker(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		if r.spec.Condition != nil {
+			holds, err := r.spec.Condition.Holds(runner, resource)
+			if err != nil {
+				return err
+			}
+			if !holds {
+				continue
+			}
+		}
+
+		if len(resource.Labels) > 1 && len(keyResources[resource.Labels[1]]) > 0 {
+			if err := r.checkKeyResources(runner, rotation, keyResources[resource.Labels[1]]); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if len(path) == 0 {
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("%s is not encrypted with a customer-managed key, an %s should set %s", r.spec.Title, r.spec.KeyResource.ResourceType, r.spec.KeyResource.KeyAttributes[0]),
+				resource.DefRange,
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := r.checkAttribute(runner, rotation, resource); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkAttribute checks the attribute holding the key ID in the nested blocks of the spec
+func (r *CustomerManagedKey) checkAttribute(runner tflint.Runner, rotation *keyRotationChecker, resource *hclext.Block) error {
+	blockTypes := r.spec.AttributePath[:len(r.spec.AttributePath)-1]
+	attributeName := r.spec.AttributePath[len(r.spec.AttributePath)-1]
+
+	suffix := ""
+	if r.spec.KeyResource != nil {
+		suffix = fmt.Sprintf(", inline or with an %s", r.spec.KeyResource.ResourceType)
+	}
+
+	block := resource
+	for _, blockType := range blockTypes {
+		nestedBlocks := block.Body.Blocks.OfType(blockType)
+		if len(nestedBlocks) == 0 {
+			return runner.EmitIssue(
+				r,
+				fmt.Sprintf("%s block is missing, the %s should be encrypted with a customer-managed key%s", blockType, r.spec.Title, suffix),
+				block.DefRange,
+			)
+		}
+		block = nestedBlocks[0]
+	}
+
+	attribute, exists := block.Body.Attributes[attributeName]
+	if !exists {
+		message := fmt.Sprintf("%s is not defined, the %s should be encrypted with a customer-managed key%s", attributeName, r.spec.Title, suffix)
+		if block != resource {
+			message = fmt.Sprintf("%s is missing in %s, the %s should be encrypted with a customer-managed key%s", attributeName, block.Type, r.spec.Title, suffix)
+		}
+		return runner.EmitIssue(r, message, block.DefRange)
+	}
+
+	return rotation.Check(runner, r, attribute.Expr)
+}
+
+// checkKeyResources checks that the key resources of a resource set the key
+func (r *CustomerManagedKey) checkKeyResources(runner tflint.Runner, rotation *keyRotationChecker, blocks []*hclext.Block) error {
+	for _, block := range blocks {
+		keySet := false
+		for _, name := range r.spec.KeyResource.KeyAttributes {
+			attribute, exists := block.Body.Attributes[name]
+			if !exists {
+				continue
+			}
+			keySet = true
+			if err := rotation.Check(runner, r, attribute.Expr); err != nil {
+				return err
+			}
+		}
+
+		if !keySet {
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("%s is not defined, the %s is encrypted with a service-managed key", r.spec.KeyResource.KeyAttributes[0], r.spec.Title),
+				block.DefRange,
+			); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// keyRotationChecker reports the customer-managed keys referencing azurerm_key_vault_key resources without a rotation policy
+type keyRotationChecker struct {
+	resolver *referenceResolver
+	keys     map[string][]*hclext.Block
+	// diskEncryptionSets holds the key_vault_key_id expressions of the azurerm_disk_encryption_set resources by name
+	diskEncryptionSets map[string][]hcl.Expression
+}
+
+// newKeyRotationChecker returns a checker for the azurerm_key_vault_key resources of the module
+func newKeyRotationChecker(runner tflint.Runner) (*keyRotationChecker, error) {
+	resolver, err := newReferenceResolver(runner)
+	if err != nil {
+		return nil, err
+	}
+
+	resources, err := runner.GetResourceContent("azurerm_key_vault_key", keyVaultKeyRotationPolicySchema, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return nil, err
+	}
+
+	keys := map[string][]*hclext.Block{}
+	for _, resource := range resources.Blocks {
+		if len(resource.Labels) > 1 {
+			keys[resource.Labels[1]] = append(keys[resource.Labels[1]], resource)
+		}
+	}
+
+	sets, err := runner.GetResourceContent("azurerm_disk_encryption_set", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "key_vault_key_id"}},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return nil, err
+	}
+
+	diskEncryptionSets := map[string][]hcl.Expression{}
+	for _, set := range sets.Blocks {
+		if attribute, exists := set.Body.Attributes["key_vault_key_id"]; exists && len(set.Labels) > 1 {
+			diskEncryptionSets[set.Labels[1]] = append(diskEncryptionSets[set.Labels[1]], attribute.Expr)
+		}
+	}
+	return &keyRotationChecker{resolver: resolver, keys: keys, diskEncryptionSets: diskEncryptionSets}, nil
+}
+
+// Check reports the keys referenced by the expression that have no rotation_policy block with expire_after set,
+// using the checks of the azurerm_key_vault_key_rotation_policy rule.
+// Keys of the disk encryption sets referenced by the expression, such as a disk_encryption_set_id, are also checked.
+func (c *keyRotationChecker) Check(runner tflint.Runner, rule tflint.Rule, expr hcl.Expression) error {
+	names := c.keyNames(expr, nil)
+	for _, set := range c.resolver.ReferencedResources(expr, "azurerm_disk_encryption_set") {
+		for _, keyExpr := range c.diskEncryptionSets[set] {
+			names = c.keyNames(keyExpr, names)
+		}
+	}
+
+	for _, name := range names {
+		for _, key := range c.keys[name] {
+			message, _, ok := keyVaultKeyRotationPolicyIssue(key)
+			if !ok {
+				continue
+			}
+			if err := runner.EmitIssue(
+				rule,
+				fmt.Sprintf("customer-managed key azurerm_key_vault_key.%s is not rotated automatically, %s", name, message),
+				expr.Range(),
+			); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// keyNames appends the names of the azurerm_key_vault_key resources referenced by the expression that are not listed yet
+func (c *keyRotationChecker) keyNames(expr hcl.Expression, names []string) []string {
+	for _, attribute := range keyVaultKeyReferenceAttributes {
+		for _, name := range c.resolver.ReferencedResourcesBy(expr, "azurerm_key_vault_key", attribute) {
+			if !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
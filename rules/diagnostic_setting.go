@@ -0,0 +1,214 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+)
+
+// diagnosticSettingTarget attaches diagnostic settings to resources through their target_resource_id.
+// Logs are read from enabled_log blocks, and from the log blocks of azurerm v3.
+var diagnosticSettingTarget = association{
+	ResourceType: "azurerm_monitor_diagnostic_setting",
+	Path:         []string{"target_resource_id"},
+	Attributes:   []string{"log_analytics_workspace_id", "storage_account_id"},
+	Blocks: []hclext.BlockSchema{
+		{
+			Type: "enabled_log",
+			Body: &hclext.BodySchema{Attributes: []hclext.AttributeSchema{{Name: "category"}, {Name: "category_group"}}},
+		},
+		{
+			Type: "log",
+			Body: &hclext.BodySchema{Attributes: []hclext.AttributeSchema{{Name: "category"}, {Name: "category_group"}, {Name: "enabled"}}},
+		},
+		{
+			Type:       "dynamic",
+			LabelNames: []string{"name"},
+		},
+	},
+}
+
+// auditCategoryGroups are the category groups that include the audit logs of every resource type
+var auditCategoryGroups = []string{"audit", "allLogs"}
+
+// diagnosticSettingSpec declares a resource type whose audit logs must be collected by a diagnostic setting
+type diagnosticSettingSpec struct {
+	ResourceType string
+	// Title is the name of the resource type used in issue messages
+	Title string
+	// Categories are the audit log categories that must be sent when no audit category group is sent
+	Categories []string
+}
+
+// diagnosticSettingSpecs are the resource types checked by the diagnostic setting rules
+var diagnosticSettingSpecs = []diagnosticSettingSpec{
+	{ResourceType: "azurerm_eventhub_namespace", Title: "EventHub Namespace", Categories: []string{"RuntimeAuditLogs"}},
+	{ResourceType: "azurerm_key_vault", Title: "Key Vault", Categories: []string{"AuditEvent"}},
+	{ResourceType: "azurerm_linux_function_app", Title: "Linux Function App", Categories: []string{"FunctionAppLogs"}},
+	{ResourceType: "azurerm_linux_web_app", Title: "Linux Web App", Categories: []string{"AppServiceAuditLogs"}},
+	{ResourceType: "azurerm_mssql_server", Title: "SQL Server", Categories: []string{"SQLSecurityAuditEvents"}},
+	{ResourceType: "azurerm_network_security_group", Title: "Network Security Group", Categories: []string{"NetworkSecurityGroupEvent", "NetworkSecurityGroupRuleCounter"}},
+	{ResourceType: "azurerm_storage_account", Title: "Storage Account", Categories: []string{"StorageRead", "StorageWrite", "StorageDelete"}},
+	{ResourceType: "azurerm_windows_function_app", Title: "Windows Function App", Categories: []string{"FunctionAppLogs"}},
+	{ResourceType: "azurerm_windows_web_app", Title: "Windows Web App", Categories: []string{"AppServiceAuditLogs"}},
+}
+
+// DiagnosticSetting checks that the audit logs of resources are sent to a Log Analytics workspace or a storage account
+type DiagnosticSetting struct {
+	tflint.DefaultRule
+
+	resourceType string
+	spec         diagnosticSettingSpec
+}
+
+// NewDiagnosticSettingRules returns a rule instance for every resource type whose audit logs must be collected
+func NewDiagnosticSettingRules() []tflint.Rule {
+	var rules []tflint.Rule
+	for _, spec := range diagnosticSettingSpecs {
+		rules = append(rules, &DiagnosticSetting{
+			resourceType: spec.ResourceType,
+			spec:         spec,
+		})
+	}
+	return rules
+}
+
+// Name returns the rule name
+func (r *DiagnosticSetting) Name() string {
+	return r.resourceType + "_diagnostic_setting"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *DiagnosticSetting) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *DiagnosticSetting) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *DiagnosticSetting) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks that every resource is the target_resource_id of an azurerm_monitor_diagnostic_setting
+// that sends its audit log categories to a Log Analytics workspace or a storage account
+func (r *DiagnosticSetting) Check(runner tflint.Runner) error {
+	categories := r.spec.Categories
+	config := diagnosticSettingRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+	if len(config.Categories) > 0 {
+		categories = config.Categories
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	settings, err := newAssociationIndex(runner, diagnosticSettingTarget, r.resourceType)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		if len(resource.Labels) < 2 {
+			continue
+		}
+		name := resource.Labels[1]
+
+		if len(settings[name]) == 0 {
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("%s '%s' is not the target_resource_id of any azurerm_monitor_diagnostic_setting", r.spec.Title, name),
+				resource.DefRange,
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		missing, known, err := r.missingCategories(runner, settings[name], categories)
+		if err != nil {
+			return err
+		}
+		if !known || len(missing) == 0 {
+			continue
+		}
+
+		message := fmt.Sprintf("%s '%s' has no diagnostic setting sending the %s log categories or the audit category group to a Log Analytics workspace or storage account", r.spec.Title, name, strings.Join(missing, ", "))
+		if len(missing) == 1 {
+			message = fmt.Sprintf("%s '%s' has no diagnostic setting sending the %s log category or the audit category group to a Log Analytics workspace or storage account", r.spec.Title, name, missing[0])
+		}
+		if err := runner.EmitIssue(r, message, resource.DefRange); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// missingCategories returns the categories not sent to a Log Analytics workspace or a storage account by any of the settings.
+// known is false when the logs of a setting cannot be evaluated, such as those of dynamic blocks.
+func (r *DiagnosticSetting) missingCategories(runner tflint.Runner, settings []*hclext.Block, categories []string) ([]string, bool, error) {
+	sent := map[string]bool{}
+	for _, setting := range settings {
+		_, toWorkspace := setting.Body.Attributes["log_analytics_workspace_id"]
+		_, toStorage := setting.Body.Attributes["storage_account_id"]
+		if !toWorkspace && !toStorage {
+			continue
+		}
+		if len(setting.Body.Blocks.OfType("dynamic")) > 0 {
+			return nil, false, nil
+		}
+
+		for _, log := range append(setting.Body.Blocks.OfType("enabled_log"), setting.Body.Blocks.OfType("log")...) {
+			if enabled, exists := log.Body.Attributes["enabled"]; exists {
+				var disabled bool
+				if err := runner.EvaluateExpr(enabled.Expr, func(val bool) error {
+					disabled = !val
+					return nil
+				}, nil); err != nil {
+					return nil, false, err
+				}
+				if disabled {
+					continue
+				}
+			}
+
+			for _, attributeName := range []string{"category", "category_group"} {
+				attribute, exists := log.Body.Attributes[attributeName]
+				if !exists {
+					continue
+				}
+				values, known, err := evaluateStrings(runner, attribute.Expr)
+				if err != nil || !known {
+					return nil, false, err
+				}
+				for _, value := range values {
+					sent[strings.ToLower(value)] = true
+				}
+			}
+		}
+	}
+
+	if slices.ContainsFunc(auditCategoryGroups, func(group string) bool { return sent[strings.ToLower(group)] }) {
+		return nil, true, nil
+	}
+	var missing []string
+	for _, category := range categories {
+		if !sent[strings.ToLower(category)] {
+			missing = append(missing, category)
+		}
+	}
+	return missing, true, nil
+}
@@ -0,0 +1,265 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_DiagnosticSetting(t *testing.T) {
+	keyVaultRule := findRule[*DiagnosticSetting](t, NewDiagnosticSettingRules(), "azurerm_key_vault_diagnostic_setting")
+	storageRule := findRule[*DiagnosticSetting](t, NewDiagnosticSettingRules(), "azurerm_storage_account_diagnostic_setting")
+
+	keyVaultDefRange := hcl.Range{
+		Filename: "resource.tf",
+		Start:    hcl.Pos{Line: 2, Column: 1},
+		End:      hcl.Pos{Line: 2, Column: 39},
+	}
+	storageDefRange := hcl.Range{
+		Filename: "resource.tf",
+		Start:    hcl.Pos{Line: 2, Column: 1},
+		End:      hcl.Pos{Line: 2, Column: 45},
+	}
+
+	tests := []struct {
+		Name     string
+		Rule     *DiagnosticSetting
+		Config   string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "no diagnostic setting",
+			Rule: keyVaultRule,
+			Content: `
+resource "azurerm_key_vault" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    keyVaultRule,
+					Message: "Key Vault 'example' is not the target_resource_id of any azurerm_monitor_diagnostic_setting",
+					Range:   keyVaultDefRange,
+				},
+			},
+		},
+		{
+			Name: "audit category sent to a Log Analytics workspace",
+			Rule: keyVaultRule,
+			Content: `
+resource "azurerm_key_vault" "example" {
+}
+
+resource "azurerm_monitor_diagnostic_setting" "example" {
+  target_resource_id         = azurerm_key_vault.example.id
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.example.id
+
+  enabled_log {
+    category = "AuditEvent"
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "audit category group sent to a storage account",
+			Rule: keyVaultRule,
+			Content: `
+resource "azurerm_key_vault" "example" {
+}
+
+resource "azurerm_monitor_diagnostic_setting" "example" {
+  target_resource_id = azurerm_key_vault.example.id
+  storage_account_id = azurerm_storage_account.logs.id
+
+  enabled_log {
+    category_group = "audit"
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "audit category missing",
+			Rule: keyVaultRule,
+			Content: `
+resource "azurerm_key_vault" "example" {
+}
+
+resource "azurerm_monitor_diagnostic_setting" "example" {
+  target_resource_id         = azurerm_key_vault.example.id
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.example.id
+
+  enabled_log {
+    category = "AzurePolicyEvaluationDetails"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    keyVaultRule,
+					Message: "Key Vault 'example' has no diagnostic setting sending the AuditEvent log category or the audit category group to a Log Analytics workspace or storage account",
+					Range:   keyVaultDefRange,
+				},
+			},
+		},
+		{
+			Name: "audit category sent to an event hub only",
+			Rule: keyVaultRule,
+			Content: `
+resource "azurerm_key_vault" "example" {
+}
+
+resource "azurerm_monitor_diagnostic_setting" "example" {
+  target_resource_id             = azurerm_key_vault.example.id
+  eventhub_authorization_rule_id = azurerm_eventhub_namespace_authorization_rule.example.id
+
+  enabled_log {
+    category = "AuditEvent"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    keyVaultRule,
+					Message: "Key Vault 'example' has no diagnostic setting sending the AuditEvent log category or the audit category group to a Log Analytics workspace or storage account",
+					Range:   keyVaultDefRange,
+				},
+			},
+		},
+		{
+			Name: "disabled log block of azurerm v3",
+			Rule: keyVaultRule,
+			Content: `
+resource "azurerm_key_vault" "example" {
+}
+
+resource "azurerm_monitor_diagnostic_setting" "example" {
+  target_resource_id         = azurerm_key_vault.example.id
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.example.id
+
+  log {
+    category = "AuditEvent"
+    enabled  = false
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    keyVaultRule,
+					Message: "Key Vault 'example' has no diagnostic setting sending the AuditEvent log category or the audit category group to a Log Analytics workspace or storage account",
+					Range:   keyVaultDefRange,
+				},
+			},
+		},
+		{
+			Name: "categories from a dynamic block",
+			Rule: keyVaultRule,
+			Content: `
+resource "azurerm_key_vault" "example" {
+}
+
+resource "azurerm_monitor_diagnostic_setting" "example" {
+  target_resource_id         = azurerm_key_vault.example.id
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.example.id
+
+  dynamic "enabled_log" {
+    for_each = var.categories
+    content {
+      category = enabled_log.value
+    }
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "storage categories split across settings of a service",
+			Rule: storageRule,
+			Content: `
+resource "azurerm_storage_account" "example" {
+}
+
+resource "azurerm_monitor_diagnostic_setting" "read" {
+  target_resource_id         = "${azurerm_storage_account.example.id}/blobServices/default"
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.example.id
+
+  enabled_log {
+    category = "StorageRead"
+  }
+}
+
+resource "azurerm_monitor_diagnostic_setting" "write" {
+  target_resource_id         = "${azurerm_storage_account.example.id}/blobServices/default"
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.example.id
+
+  enabled_log {
+    category = "StorageWrite"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    storageRule,
+					Message: "Storage Account 'example' has no diagnostic setting sending the StorageDelete log category or the audit category group to a Log Analytics workspace or storage account",
+					Range:   storageDefRange,
+				},
+			},
+		},
+		{
+			Name: "diagnostic settings created with for_each",
+			Rule: storageRule,
+			Content: `
+resource "azurerm_storage_account" "example" {
+}
+
+resource "azurerm_monitor_diagnostic_setting" "example" {
+  for_each                   = azurerm_storage_account.example
+  target_resource_id         = each.value.id
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.example.id
+
+  enabled_log {
+    category_group = "allLogs"
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "categories set in the rule block",
+			Rule: storageRule,
+			Config: `
+rule "azurerm_storage_account_diagnostic_setting" {
+  enabled    = true
+  categories = ["StorageWrite", "StorageDelete"]
+}`,
+			Content: `
+resource "azurerm_storage_account" "example" {
+}
+
+resource "azurerm_monitor_diagnostic_setting" "example" {
+  target_resource_id         = "${azurerm_storage_account.example.id}/blobServices/default"
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.example.id
+
+  enabled_log {
+    category = "StorageRead"
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    storageRule,
+					Message: "Storage Account 'example' has no diagnostic setting sending the StorageWrite, StorageDelete log categories or the audit category group to a Log Analytics workspace or storage account",
+					Range:   storageDefRange,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			files := map[string]string{"resource.tf": test.Content}
+			if test.Config != "" {
+				files[".tflint.hcl"] = test.Config
+			}
+			runner := helper.TestRunner(t, files)
+
+			if err := test.Rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// eventhubAuthorizationRuleResourceTypes are the shared access policies of Event Hub namespaces and event hubs
+var eventhubAuthorizationRuleResourceTypes = []string{
+	"azurerm_eventhub_namespace_authorization_rule",
+	"azurerm_eventhub_authorization_rule",
+}
+
+// premiumEventhubNamespace restricts rules to the Premium SKU, the only one supporting customer-managed keys outside of dedicated clusters
+var premiumEventhubNamespace = &AttributeRuleCondition{Attribute: "sku", Values: []string{"Premium"}}
+
+// eventhubNamespaceRuleSpecs declares the attribute rules of the Event Hub namespaces and their authorization rules
+var eventhubNamespaceRuleSpecs = []*AttributeRuleSpec{
+	{
+		Name:          "local_authentication_enabled",
+		ResourceTypes: []string{"azurerm_eventhub_namespace"},
+		AttributePath: []string{"local_authentication_enabled"},
+		Type:          cty.Bool,
+		Expected:      []string{"false"},
+		Enabled:       true,
+		Severity:      tflint.WARNING,
+		Messages: AttributeRuleMessages{
+			MissingAttribute: "local_authentication_enabled is not defined and defaults to true, should be false",
+			InvalidValue:     "local_authentication_enabled should be false, shared access keys bypass Microsoft Entra authorization",
+		},
+	},
+	newAuthorizationRuleManageSpec(eventhubAuthorizationRuleResourceTypes),
+}
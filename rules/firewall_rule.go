@@ -0,0 +1,127 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/rules/iprange"
+)
+
+// firewallRuleSpec declares a firewall rule resource type whose range is set by a start and an end IP address
+type firewallRuleSpec struct {
+	ResourceType string
+	StartIPAttr  string
+	EndIPAttr    string
+	// AzureServices is whether the 0.0.0.0-0.0.0.0 range allows access from all Azure services
+	AzureServices bool
+}
+
+// firewallRuleSpecs are the resource types checked by the firewall rule rules
+var firewallRuleSpecs = []firewallRuleSpec{
+	{ResourceType: "azurerm_mssql_firewall_rule", StartIPAttr: "start_ip_address", EndIPAttr: "end_ip_address", AzureServices: true},
+	{ResourceType: "azurerm_mysql_flexible_server_firewall_rule", StartIPAttr: "start_ip_address", EndIPAttr: "end_ip_address", AzureServices: true},
+	{ResourceType: "azurerm_postgresql_flexible_server_firewall_rule", StartIPAttr: "start_ip_address", EndIPAttr: "end_ip_address", AzureServices: true},
+	{ResourceType: "azurerm_synapse_firewall_rule", StartIPAttr: "start_ip_address", EndIPAttr: "end_ip_address", AzureServices: true},
+}
+
+// FirewallRuleAllAllowed checks if the firewall rule allows all IP addresses, all Azure services or a wide public range
+type FirewallRuleAllAllowed struct {
+	tflint.DefaultRule
+
+	resourceType string
+	spec         firewallRuleSpec
+}
+
+// NewFirewallRuleAllAllowedRules returns a rule instance for every firewall rule resource type
+func NewFirewallRuleAllAllowedRules() []tflint.Rule {
+	var rules []tflint.Rule
+	for _, spec := range firewallRuleSpecs {
+		rules = append(rules, &FirewallRuleAllAllowed{
+			resourceType: spec.ResourceType,
+			spec:         spec,
+		})
+	}
+	return rules
+}
+
+// Name returns the rule name
+func (r *FirewallRuleAllAllowed) Name() string {
+	return r.resourceType + "_all_allowed"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *FirewallRuleAllAllowed) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *FirewallRuleAllAllowed) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *FirewallRuleAllAllowed) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks if the firewall rule allows all IP addresses, all Azure services or a wide public range
+func (r *FirewallRuleAllAllowed) Check(runner tflint.Runner) error {
+	policy, err := decodeIPRangePolicy(runner, r.Name())
+	if err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: r.spec.StartIPAttr},
+			{Name: r.spec.EndIPAttr},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		startIP, exists := resource.Body.Attributes[r.spec.StartIPAttr]
+		if !exists {
+			continue
+		}
+
+		endIP, exists := resource.Body.Attributes[r.spec.EndIPAttr]
+		if !exists {
+			continue
+		}
+
+		var startIPValue, endIPValue string
+		err := runner.EvaluateExpr(startIP.Expr, func(val string) error {
+			startIPValue = val
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		err = runner.EvaluateExpr(endIP.Expr, func(val string) error {
+			endIPValue = val
+			return nil
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		// Values that cannot be parsed, such as unknown references, are not reported
+		ipRange, err := iprange.ParseRange(startIPValue, endIPValue)
+		if err != nil {
+			continue
+		}
+
+		if message := policy.Violation(ipRange, "Firewall rule", r.spec.AzureServices); message != "" {
+			if err := runner.EmitIssue(r, message, resource.DefRange); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,252 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermMsSQLFirewallRuleAllAllowed(t *testing.T) {
+	rule := findRule[*FirewallRuleAllAllowed](t, NewFirewallRuleAllAllowedRules(), "azurerm_mssql_firewall_rule_all_allowed")
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected helper.Issues
+	}{
+		{
+			Name: "all IPs allowed",
+			Content: `
+resource "azurerm_mssql_firewall_rule" "example" {
+    start_ip_address = "0.0.0.0"
+    end_ip_address   = "255.255.255.255"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "Firewall rule allows access from all IP addresses (0.0.0.0-255.255.255.255). Consider restricting the IP range for better security.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 49},
+					},
+				},
+			},
+		},
+		{
+			Name: "specific IP range",
+			Content: `
+resource "azurerm_mssql_firewall_rule" "example" {
+    start_ip_address = "10.0.0.0"
+    end_ip_address   = "10.0.0.255"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "missing IP addresses",
+			Content: `
+resource "azurerm_mssql_firewall_rule" "example" {
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "range within configured allowed_ranges",
+			Content: `
+resource "azurerm_mssql_firewall_rule" "example" {
+    start_ip_address = "203.0.113.10"
+    end_ip_address   = "203.0.113.20"
+}`,
+			Config: `
+rule "azurerm_mssql_firewall_rule_all_allowed" {
+    enabled        = true
+    allowed_ranges = ["203.0.113.0/24"]
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "range outside configured allowed_ranges",
+			Content: `
+resource "azurerm_mssql_firewall_rule" "example" {
+    start_ip_address = "198.51.100.0"
+    end_ip_address   = "198.51.100.255"
+}`,
+			Config: `
+rule "azurerm_mssql_firewall_rule_all_allowed" {
+    enabled        = true
+    allowed_ranges = ["203.0.113.0/24"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "Firewall rule range 198.51.100.0-198.51.100.255 is not within the allowed ranges (203.0.113.0/24)",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start: hcl.Pos{
+							Line:   2,
+							Column: 1,
+						},
+						End: hcl.Pos{
+							Line:   2,
+							Column: 49,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "all Azure services allowed",
+			Content: `
+resource "azurerm_mssql_firewall_rule" "example" {
+    start_ip_address = "0.0.0.0"
+    end_ip_address   = "0.0.0.0"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "Firewall rule allows access from all Azure services (0.0.0.0), including those of other customers",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 49},
+					},
+				},
+			},
+		},
+		{
+			Name: "all Azure services allowed by allow_azure_services",
+			Content: `
+resource "azurerm_mssql_firewall_rule" "example" {
+    start_ip_address = "0.0.0.0"
+    end_ip_address   = "0.0.0.0"
+}`,
+			Config: `
+rule "azurerm_mssql_firewall_rule_all_allowed" {
+    enabled              = true
+    allow_azure_services = true
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "almost all IPs allowed",
+			Content: `
+resource "azurerm_mssql_firewall_rule" "example" {
+    start_ip_address = "1.0.0.0"
+    end_ip_address   = "255.255.255.254"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "Firewall rule range 1.0.0.0-255.255.255.254 holds public IP addresses and is wider than a /16",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 49},
+					},
+				},
+			},
+		},
+		{
+			Name: "wide private range",
+			Content: `
+resource "azurerm_mssql_firewall_rule" "example" {
+    start_ip_address = "10.0.0.0"
+    end_ip_address   = "10.255.255.255"
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "public range wider than the configured max_prefix_size",
+			Content: `
+resource "azurerm_mssql_firewall_rule" "example" {
+    start_ip_address = "198.51.100.0"
+    end_ip_address   = "198.51.100.255"
+}`,
+			Config: `
+rule "azurerm_mssql_firewall_rule_all_allowed" {
+    enabled         = true
+    max_prefix_size = 28
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: "Firewall rule range 198.51.100.0-198.51.100.255 holds public IP addresses and is wider than a /28",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 49},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content, ".tflint.hcl": test.Config})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
+func Test_FirewallRuleAllAllowed(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Rule     *FirewallRuleAllAllowed
+		Content  string
+		Expected string
+	}{
+		{
+			Name: "PostgreSQL flexible server",
+			Rule: findRule[*FirewallRuleAllAllowed](t, NewFirewallRuleAllAllowedRules(), "azurerm_postgresql_flexible_server_firewall_rule_all_allowed"),
+			Content: `
+resource "azurerm_postgresql_flexible_server_firewall_rule" "example" {
+  start_ip_address = "0.0.0.0"
+  end_ip_address   = "255.255.255.255"
+}`,
+			Expected: "Firewall rule allows access from all IP addresses (0.0.0.0-255.255.255.255). Consider restricting the IP range for better security.",
+		},
+		{
+			Name: "MySQL flexible server",
+			Rule: findRule[*FirewallRuleAllAllowed](t, NewFirewallRuleAllAllowedRules(), "azurerm_mysql_flexible_server_firewall_rule_all_allowed"),
+			Content: `
+resource "azurerm_mysql_flexible_server_firewall_rule" "example" {
+  start_ip_address = "0.0.0.0"
+  end_ip_address   = "0.0.0.0"
+}`,
+			Expected: "Firewall rule allows access from all Azure services (0.0.0.0), including those of other customers",
+		},
+		{
+			Name: "Synapse workspace",
+			Rule: findRule[*FirewallRuleAllAllowed](t, NewFirewallRuleAllAllowedRules(), "azurerm_synapse_firewall_rule_all_allowed"),
+			Content: `
+resource "azurerm_synapse_firewall_rule" "example" {
+  start_ip_address = "64.0.0.0"
+  end_ip_address   = "127.255.255.255"
+}`,
+			Expected: "Firewall rule range 64.0.0.0-127.255.255.255 holds public IP addresses and is wider than a /16",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := test.Rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			if len(runner.Issues) != 1 {
+				t.Fatalf("Expected 1 issue, got %d", len(runner.Issues))
+			}
+			if runner.Issues[0].Message != test.Expected {
+				t.Errorf("Expected message %q, got %q", test.Expected, runner.Issues[0].Message)
+			}
+		})
+	}
+}
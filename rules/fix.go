@@ -0,0 +1,107 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// fixReplaceLiteral returns a fix that rewrites the attribute value to the given value.
+// Only literal values are rewritten, expressions such as variable references are left untouched.
+func fixReplaceLiteral(attribute *hclext.Attribute, value cty.Value) func(f tflint.Fixer) error {
+	return func(f tflint.Fixer) error {
+		if !isLiteralExpr(attribute.Expr) {
+			return tflint.ErrFixNotSupported
+		}
+		return f.ReplaceText(attribute.Expr.Range(), f.ValueText(value))
+	}
+}
+
+// fixRenameAttribute returns a fix that renames the attribute, keeping its value
+func fixRenameAttribute(attribute *hclext.Attribute, name string) func(f tflint.Fixer) error {
+	return func(f tflint.Fixer) error {
+		return f.ReplaceText(attribute.NameRange, name)
+	}
+}
+
+// fixInsertAttribute returns a fix that adds the attribute with the given value to the block
+func fixInsertAttribute(runner tflint.Runner, block *hclext.Block, name string, value cty.Value) func(f tflint.Fixer) error {
+	return func(f tflint.Fixer) error {
+		return insertIntoBlock(runner, f, block, fmt.Sprintf("%s = %s", name, f.ValueText(value)))
+	}
+}
+
+// fixInsertNestedBlock returns a fix that adds nested blocks to the block, following the path of block types
+// up to the attribute, which is set to the given value
+func fixInsertNestedBlock(runner tflint.Runner, block *hclext.Block, path []string, value cty.Value) func(f tflint.Fixer) error {
+	return func(f tflint.Fixer) error {
+		text := fmt.Sprintf("%s = %s", path[len(path)-1], f.ValueText(value))
+		for i := len(path) - 2; i >= 0; i-- {
+			text = fmt.Sprintf("%s {\n%s\n}", path[i], text)
+		}
+		return insertIntoBlock(runner, f, block, text)
+	}
+}
+
+// isLiteralExpr returns whether the expression is a literal value written in native syntax
+func isLiteralExpr(expr hcl.Expression) bool {
+	switch expr := expr.(type) {
+	case *hclsyntax.LiteralValueExpr:
+		return true
+	case *hclsyntax.TemplateExpr:
+		return expr.IsStringLiteral()
+	default:
+		return false
+	}
+}
+
+// insertIntoBlock inserts the text as the last line of the body of the block
+func insertIntoBlock(runner tflint.Runner, f tflint.Fixer, block *hclext.Block, text string) error {
+	syntaxBlock, err := findSyntaxBlock(runner, block.DefRange)
+	if err != nil {
+		return err
+	}
+	if syntaxBlock == nil {
+		return tflint.ErrFixNotSupported
+	}
+
+	// A block written on a single line, such as `site_config {}`, needs a line break after the opening brace
+	if syntaxBlock.OpenBraceRange.Start.Line == syntaxBlock.CloseBraceRange.Start.Line {
+		text = "\n" + text
+	}
+	return f.InsertTextBefore(syntaxBlock.CloseBraceRange, text+"\n")
+}
+
+// findSyntaxBlock returns the native syntax block declared at the given range.
+// It returns nil when the block is not written in native syntax, such as in JSON files.
+func findSyntaxBlock(runner tflint.Runner, defRange hcl.Range) (*hclsyntax.Block, error) {
+	file, err := runner.GetFile(defRange.Filename)
+	if err != nil {
+		return nil, err
+	}
+	if file == nil {
+		return nil, nil
+	}
+
+	body, ok := file.Body.(*hclsyntax.Body)
+	if !ok {
+		return nil, nil
+	}
+	return findSyntaxBlockInBody(body, defRange), nil
+}
+
+func findSyntaxBlockInBody(body *hclsyntax.Body, defRange hcl.Range) *hclsyntax.Block {
+	for _, block := range body.Blocks {
+		if block.DefRange().Start.Byte == defRange.Start.Byte {
+			return block
+		}
+		if block.Body.Range().ContainsPos(defRange.Start) {
+			return findSyntaxBlockInBody(block.Body, defRange)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+func Test_IsLiteralExpr(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Source   string
+		Expected bool
+	}{
+		{Name: "string", Source: `"1.2"`, Expected: true},
+		{Name: "bool", Source: `false`, Expected: true},
+		{Name: "variable", Source: `var.tls_version`, Expected: false},
+		{Name: "interpolation", Source: `"${var.tls_version}"`, Expected: false},
+		{Name: "function call", Source: `lower("Deny")`, Expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			expr, diags := hclsyntax.ParseExpression([]byte(test.Source), "test.tf", hcl.InitialPos)
+			if diags.HasErrors() {
+				t.Fatal(diags)
+			}
+			if actual := isLiteralExpr(expr); actual != test.Expected {
+				t.Errorf("isLiteralExpr(%s) = %t, expected %t", test.Source, actual, test.Expected)
+			}
+		})
+	}
+}
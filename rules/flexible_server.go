@@ -0,0 +1,410 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+)
+
+// flexibleServerResourceTypes are the flexible servers of Azure Database for PostgreSQL and MySQL
+var flexibleServerResourceTypes = []string{
+	"azurerm_mysql_flexible_server",
+	"azurerm_postgresql_flexible_server",
+}
+
+// Values used by the flexible servers for their TLS version server parameters, from oldest to newest
+var flexibleServerTLSVersions = []string{"TLSv1", "TLSv1.1", "TLSv1.2", "TLSv1.3"}
+
+// flexibleServerRuleSpecs declares the attribute rules of the flexible servers
+var flexibleServerRuleSpecs = []*AttributeRuleSpec{
+	{
+		Name:          "geo_redundant_backup_enabled",
+		ResourceTypes: flexibleServerResourceTypes,
+		AttributePath: []string{"geo_redundant_backup_enabled"},
+		Type:          cty.Bool,
+		Expected:      []string{"true"},
+		Enabled:       true,
+		Severity:      tflint.NOTICE,
+		Messages: AttributeRuleMessages{
+			MissingAttribute: "geo_redundant_backup_enabled is not defined and should be true",
+			InvalidValue:     "geo_redundant_backup_enabled should be true",
+		},
+		// Changing geo_redundant_backup_enabled recreates the server
+		NoFix: true,
+	},
+	// MySQL flexible servers have no authentication block, see the active directory administrator
+	// and aad_auth_only configuration rules
+	{
+		Name:          "active_directory_auth_enabled",
+		ResourceTypes: []string{"azurerm_postgresql_flexible_server"},
+		AttributePath: []string{"authentication", "active_directory_auth_enabled"},
+		Type:          cty.Bool,
+		Expected:      []string{"true"},
+		Enabled:       true,
+		Severity:      tflint.WARNING,
+		Messages: AttributeRuleMessages{
+			MissingBlock:     "authentication block is missing, active_directory_auth_enabled should be true",
+			MissingAttribute: "active_directory_auth_enabled is missing in authentication, should be true",
+			InvalidValue:     "active_directory_auth_enabled should be true",
+		},
+		// tenant_id is required in authentication with Microsoft Entra authentication
+		NoBlockFix: true,
+	},
+	{
+		Name:          "password_auth_enabled",
+		ResourceTypes: []string{"azurerm_postgresql_flexible_server"},
+		AttributePath: []string{"authentication", "password_auth_enabled"},
+		Type:          cty.Bool,
+		Expected:      []string{"false"},
+		Enabled:       true,
+		Severity:      tflint.WARNING,
+		Messages: AttributeRuleMessages{
+			MissingBlock:     "authentication block is missing and password authentication defaults to enabled, password_auth_enabled should be false",
+			MissingAttribute: "password_auth_enabled is missing in authentication and defaults to true, should be false",
+			InvalidValue:     "password_auth_enabled should be false",
+		},
+		// Password authentication can only be disabled together with the enabling of Microsoft Entra authentication
+		NoBlockFix: true,
+	},
+}
+
+// flexibleServerPublicNetworkAccessArgument is the argument disabling the public network access of a flexible server
+type flexibleServerPublicNetworkAccessArgument struct {
+	Name     string
+	Disabled cty.Value
+}
+
+// flexibleServerPublicNetworkAccessArguments are the arguments disabling public network access by resource type.
+// public_network_access_enabled is computed by MySQL flexible servers, which are set with public_network_access.
+var flexibleServerPublicNetworkAccessArguments = map[string]flexibleServerPublicNetworkAccessArgument{
+	"azurerm_mysql_flexible_server":      {Name: "public_network_access", Disabled: cty.StringVal("Disabled")},
+	"azurerm_postgresql_flexible_server": {Name: "public_network_access_enabled", Disabled: cty.False},
+}
+
+// FlexibleServerPublicNetworkAccess checks that flexible servers are not reachable from public networks
+type FlexibleServerPublicNetworkAccess struct {
+	tflint.DefaultRule
+
+	resourceType string
+	argument     flexibleServerPublicNetworkAccessArgument
+}
+
+// NewFlexibleServerPublicNetworkAccessRules returns a rule instance for every flexible server resource type
+func NewFlexibleServerPublicNetworkAccessRules() []tflint.Rule {
+	var rules []tflint.Rule
+	for _, resourceType := range flexibleServerResourceTypes {
+		rules = append(rules, &FlexibleServerPublicNetworkAccess{
+			resourceType: resourceType,
+			argument:     flexibleServerPublicNetworkAccessArguments[resourceType],
+		})
+	}
+	return rules
+}
+
+// Name returns the rule name
+func (r *FlexibleServerPublicNetworkAccess) Name() string {
+	return r.resourceType + "_public_network_access_enabled"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *FlexibleServerPublicNetworkAccess) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *FlexibleServerPublicNetworkAccess) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *FlexibleServerPublicNetworkAccess) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks that public_network_access_enabled is false or public_network_access is Disabled, or that the server
+// is integrated in a virtual network with delegated_subnet_id. Servers setting neither are fixed with the argument
+// of their resource type.
+func (r *FlexibleServerPublicNetworkAccess) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "public_network_access_enabled"},
+			{Name: "public_network_access"},
+			{Name: "delegated_subnet_id"},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		// Servers integrated in a virtual network have no public endpoint
+		if _, exists := resource.Body.Attributes["delegated_subnet_id"]; exists {
+			continue
+		}
+
+		if attribute, exists := resource.Body.Attributes["public_network_access"]; exists {
+			if err := runner.EvaluateExpr(attribute.Expr, func(val string) error {
+				if strings.EqualFold(val, "Disabled") {
+					return nil
+				}
+				return runner.EmitIssueWithFix(
+					r,
+					fmt.Sprintf("public_network_access is set to %s, consider disabling it or setting delegated_subnet_id", val),
+					attribute.Expr.Range(),
+					fixReplaceLiteral(attribute, cty.StringVal("Disabled")),
+				)
+			}, nil); err != nil {
+				return err
+			}
+			continue
+		}
+
+		attribute, exists := resource.Body.Attributes["public_network_access_enabled"]
+		if !exists {
+			if err := runner.EmitIssueWithFix(
+				r,
+				r.missingMessage(),
+				resource.DefRange,
+				fixInsertAttribute(runner, resource, r.argument.Name, r.argument.Disabled),
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := runner.EvaluateExpr(attribute.Expr, func(val bool) error {
+			if !val {
+				return nil
+			}
+			return runner.EmitIssueWithFix(
+				r,
+				"Consider changing public_network_access_enabled to false or setting delegated_subnet_id",
+				attribute.Expr.Range(),
+				fixReplaceLiteral(attribute, cty.False),
+			)
+		}, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// missingMessage returns the message of servers that do not set the public network access argument of their resource type
+func (r *FlexibleServerPublicNetworkAccess) missingMessage() string {
+	if r.argument.Disabled.Type() == cty.String {
+		return fmt.Sprintf("%s is not defined and defaults to Enabled, consider setting it to %s or setting delegated_subnet_id", r.argument.Name, r.argument.Disabled.AsString())
+	}
+	return fmt.Sprintf("%s is not defined and defaults to true, consider disabling it or setting delegated_subnet_id", r.argument.Name)
+}
+
+// flexibleServerConfigurations are the resources setting the server parameters of the flexible servers.
+// MySQL configurations refer to their server by name.
+var flexibleServerConfigurations = map[string]association{
+	"azurerm_mysql_flexible_server": {
+		ResourceType:       "azurerm_mysql_flexible_server_configuration",
+		Path:               []string{"server_name"},
+		Attributes:         []string{"name", "value"},
+		ReferenceAttribute: "name",
+	},
+	"azurerm_postgresql_flexible_server": {
+		ResourceType: "azurerm_postgresql_flexible_server_configuration",
+		Path:         []string{"server_id"},
+		Attributes:   []string{"name", "value"},
+	},
+}
+
+// flexibleServerConfigurationSpec declares a server parameter that must be set by a configuration resource
+type flexibleServerConfigurationSpec struct {
+	ResourceType string
+	// Parameter is the name of the server parameter, also used as the rule name without the resource type prefix
+	Parameter string
+	// Expected lists the accepted values, compared case-insensitively.
+	// Every value of a comma-separated list, such as the TLS versions of tls_version, must be accepted.
+	Expected []string
+	Severity tflint.Severity
+	// TLSVersions lists the values of a TLS version parameter from oldest to newest.
+	// When set, the expected values follow the minimum_tls_version setting of the plugin block.
+	TLSVersions []string
+}
+
+// flexibleServerConfigurationSpecs are the server parameters checked by the flexible server configuration rules
+var flexibleServerConfigurationSpecs = []flexibleServerConfigurationSpec{
+	{ResourceType: "azurerm_mysql_flexible_server", Parameter: "aad_auth_only", Expected: []string{"ON"}, Severity: tflint.WARNING},
+	{ResourceType: "azurerm_mysql_flexible_server", Parameter: "require_secure_transport", Expected: []string{"ON"}, Severity: tflint.WARNING},
+	{
+		ResourceType: "azurerm_mysql_flexible_server",
+		Parameter:    "tls_version",
+		Expected:     tlsVersionsAtLeast(DefaultMinimumTLSVersion, flexibleServerTLSVersions),
+		Severity:     tflint.WARNING,
+		TLSVersions:  flexibleServerTLSVersions,
+	},
+	{ResourceType: "azurerm_postgresql_flexible_server", Parameter: "require_secure_transport", Expected: []string{"on"}, Severity: tflint.WARNING},
+	{
+		ResourceType: "azurerm_postgresql_flexible_server",
+		Parameter:    "ssl_min_protocol_version",
+		Expected:     tlsVersionsAtLeast(DefaultMinimumTLSVersion, flexibleServerTLSVersions),
+		Severity:     tflint.WARNING,
+		TLSVersions:  flexibleServerTLSVersions,
+	},
+}
+
+// FlexibleServerConfiguration checks that a server parameter of flexible servers is set by a configuration resource
+type FlexibleServerConfiguration struct {
+	tflint.DefaultRule
+
+	resourceType string
+	spec         flexibleServerConfigurationSpec
+	expected     []string
+}
+
+// NewFlexibleServerConfigurationRules returns a rule instance for every server parameter of the flexible servers
+func NewFlexibleServerConfigurationRules() []tflint.Rule {
+	var rules []tflint.Rule
+	for _, spec := range flexibleServerConfigurationSpecs {
+		rules = append(rules, &FlexibleServerConfiguration{
+			resourceType: spec.ResourceType,
+			spec:         spec,
+			expected:     spec.Expected,
+		})
+	}
+	return rules
+}
+
+// Name returns the rule name
+func (r *FlexibleServerConfiguration) Name() string {
+	return r.resourceType + "_" + r.spec.Parameter
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *FlexibleServerConfiguration) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *FlexibleServerConfiguration) Severity() tflint.Severity {
+	return r.spec.Severity
+}
+
+// Link returns the rule reference link
+func (r *FlexibleServerConfiguration) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// ApplyConfig applies the plugin-wide minimum TLS version to TLS version parameters
+func (r *FlexibleServerConfiguration) ApplyConfig(config *Config) {
+	if len(r.spec.TLSVersions) > 0 {
+		r.expected = tlsVersionsAtLeast(config.MinimumTLSVersion, r.spec.TLSVersions)
+	}
+}
+
+// Check checks that every server is referenced by a configuration resource setting the parameter to an expected value
+func (r *FlexibleServerConfiguration) Check(runner tflint.Runner) error {
+	expected := r.expected
+	if len(r.spec.TLSVersions) > 0 {
+		var err error
+		if expected, err = decodeTLSVersionRuleConfig(runner, r.Name(), expected); err != nil {
+			return err
+		}
+	}
+
+	configuration := flexibleServerConfigurations[r.resourceType]
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	configurations, err := newAssociationIndex(runner, configuration, r.resourceType)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		if len(resource.Labels) < 2 {
+			continue
+		}
+		name := resource.Labels[1]
+
+		parameter, known, err := r.parameter(runner, configurations[name])
+		if err != nil {
+			return err
+		}
+		if !known {
+			continue
+		}
+		if parameter == nil {
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("No %s sets %s for '%s', it should be set to %s", configuration.ResourceType, r.spec.Parameter, name, strings.Join(expected, " or ")),
+				resource.DefRange,
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		attribute, exists := parameter.Body.Attributes["value"]
+		if !exists {
+			continue
+		}
+		values, known, err := evaluateStrings(runner, attribute.Expr)
+		if err != nil {
+			return err
+		}
+		if !known || len(values) != 1 {
+			continue
+		}
+		if !r.accepts(values[0], expected) {
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("%s is set to %s for '%s', it should be set to %s", r.spec.Parameter, values[0], name, strings.Join(expected, " or ")),
+				attribute.Expr.Range(),
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// parameter returns the configuration of the server setting the parameter, or nil when there is none.
+// known is false when the name of a configuration cannot be evaluated, such as one taken from each.value.
+func (r *FlexibleServerConfiguration) parameter(runner tflint.Runner, configurations []*hclext.Block) (*hclext.Block, bool, error) {
+	known := true
+	for _, configuration := range configurations {
+		attribute, exists := configuration.Body.Attributes["name"]
+		if !exists {
+			continue
+		}
+		names, nameKnown, err := evaluateStrings(runner, attribute.Expr)
+		if err != nil {
+			return nil, false, err
+		}
+		if !nameKnown {
+			known = false
+			continue
+		}
+		if slices.ContainsFunc(names, func(name string) bool { return strings.EqualFold(name, r.spec.Parameter) }) {
+			return configuration, true, nil
+		}
+	}
+	return nil, known, nil
+}
+
+// accepts returns whether every value of the comma-separated list is expected
+func (r *FlexibleServerConfiguration) accepts(value string, expected []string) bool {
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if !slices.ContainsFunc(expected, func(e string) bool { return strings.EqualFold(e, item) }) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,59 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// iothubResourceTypes are the resource types of the IoT Hub rules
+var iothubResourceTypes = []string{"azurerm_iothub"}
+
+// iothubRuleSpecs declares the attribute rules of the IoT Hubs and their routing endpoints
+var iothubRuleSpecs = []*AttributeRuleSpec{
+	{
+		Name:          "local_authentication_enabled",
+		ResourceTypes: iothubResourceTypes,
+		AttributePath: []string{"local_authentication_enabled"},
+		Type:          cty.Bool,
+		Expected:      []string{"false"},
+		Enabled:       true,
+		Severity:      tflint.WARNING,
+		Messages: AttributeRuleMessages{
+			MissingAttribute: "local_authentication_enabled is not defined and defaults to true, should be false",
+			InvalidValue:     "local_authentication_enabled should be false, shared access keys bypass Microsoft Entra authorization",
+		},
+	},
+	{
+		Name:          "min_tls_version",
+		ResourceTypes: iothubResourceTypes,
+		AttributePath: []string{"min_tls_version"},
+		Type:          cty.String,
+		// 1.2 is the only value supported by IoT Hub, TLS 1.0 and 1.1 are accepted when it is not set
+		Expected: []string{"1.2"},
+		Enabled:  true,
+		Severity: tflint.WARNING,
+		Messages: AttributeRuleMessages{
+			MissingAttribute: "min_tls_version is not defined and TLS 1.0 and 1.1 are accepted, should be set to {expected}",
+			InvalidValue:     "min_tls_version is set to {value}, should be {expected}",
+		},
+		// Changing min_tls_version recreates the hub
+		NoFix: true,
+	},
+	{
+		Name: "authentication_type",
+		ResourceTypes: []string{
+			"azurerm_iothub_endpoint_servicebus_queue",
+			"azurerm_iothub_endpoint_servicebus_topic",
+			"azurerm_iothub_endpoint_storage_container",
+		},
+		AttributePath: []string{"authentication_type"},
+		Type:          cty.String,
+		Expected:      []string{"identityBased"},
+		Enabled:       true,
+		Severity:      tflint.NOTICE,
+		Messages: AttributeRuleMessages{
+			MissingAttribute: `authentication_type is not defined and should be "identityBased"`,
+			InvalidValue:     `authentication_type should be "identityBased"`,
+		},
+	},
+}
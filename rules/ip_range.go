@@ -0,0 +1,66 @@
+package rules
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/rules/iprange"
+)
+
+// ipRangePolicy is the decoded `rule` block of the firewall and IP rules
+type ipRangePolicy struct {
+	config        ipRangeRuleConfig
+	allowedRanges []netip.Prefix
+}
+
+// decodeIPRangePolicy decodes the `rule` block of a firewall or IP rule
+func decodeIPRangePolicy(runner tflint.Runner, ruleName string) (*ipRangePolicy, error) {
+	policy := &ipRangePolicy{config: ipRangeRuleConfig{MaxPrefixSize: DefaultMaxPrefixSize}}
+	if err := runner.DecodeRuleConfig(ruleName, &policy.config); err != nil {
+		return nil, err
+	}
+	if policy.config.MaxPrefixSize < 0 || policy.config.MaxPrefixSize > 32 {
+		return nil, fmt.Errorf("max_prefix_size must be between 0 and 32, got %d", policy.config.MaxPrefixSize)
+	}
+
+	for _, allowedRange := range policy.config.AllowedRanges {
+		prefix, err := netip.ParsePrefix(allowedRange)
+		if err != nil {
+			return nil, fmt.Errorf("allowed_ranges contains an invalid CIDR range %q: %w", allowedRange, err)
+		}
+		policy.allowedRanges = append(policy.allowedRanges, prefix.Masked())
+	}
+	return policy, nil
+}
+
+// Violation returns the issue message of a range, or an empty string when the range is allowed.
+// subject names what allows the range in the message, such as "Firewall rule" or "ip_rules".
+// azureServices is whether the 0.0.0.0 address allows access from all Azure services for the resource type.
+func (p *ipRangePolicy) Violation(r iprange.Range, subject string, azureServices bool) string {
+	if r.All() {
+		return fmt.Sprintf("%s allows access from all IP addresses (%s). Consider restricting the IP range for better security.", subject, r)
+	}
+	if azureServices && r.AzureServices() {
+		if p.config.AllowAzureServices {
+			return ""
+		}
+		return fmt.Sprintf("%s allows access from all Azure services (%s), including those of other customers", subject, r)
+	}
+
+	if len(p.allowedRanges) > 0 {
+		if slices.ContainsFunc(p.allowedRanges, r.Within) {
+			return ""
+		}
+		return fmt.Sprintf("%s range %s is not within the allowed ranges (%s)", subject, r, strings.Join(p.config.AllowedRanges, ", "))
+	}
+
+	// Prefix sizes are those of IPv4, IPv6 ranges are only reported when they hold every address
+	if r.From.Is4() && r.Public() && r.PrefixLength() < p.config.MaxPrefixSize {
+		return fmt.Sprintf("%s range %s holds public IP addresses and is wider than a /%d", subject, r, p.config.MaxPrefixSize)
+	}
+	return ""
+}
@@ -0,0 +1,136 @@
+package rules
+
+import (
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/rules/iprange"
+)
+
+// ipRulesSpec declares a resource type that allows a list of IP addresses and CIDR ranges
+type ipRulesSpec struct {
+	ResourceType string
+	// AttributePath is the attribute holding the list, preceded by the types of the nested blocks containing it
+	AttributePath []string
+	// AzureServices is whether the 0.0.0.0 address allows access from all Azure services
+	AzureServices bool
+}
+
+// ipRulesSpecs are the resource types checked by the IP rules
+var ipRulesSpecs = []ipRulesSpec{
+	// ip_range_filter is a comma-separated string in azurerm v3 and a set of strings in v4
+	{ResourceType: "azurerm_cosmosdb_account", AttributePath: []string{"ip_range_filter"}, AzureServices: true},
+	{ResourceType: "azurerm_key_vault", AttributePath: []string{"network_acls", "ip_rules"}},
+	{ResourceType: "azurerm_storage_account", AttributePath: []string{"network_rules", "ip_rules"}},
+}
+
+// IPRules checks that the IP addresses and CIDR ranges allowed by a resource are not all IP addresses,
+// all Azure services or wide public ranges
+type IPRules struct {
+	tflint.DefaultRule
+
+	resourceType string
+	spec         ipRulesSpec
+}
+
+// NewIPRulesRules returns a rule instance for every resource type that allows a list of IP addresses
+func NewIPRulesRules() []tflint.Rule {
+	var rules []tflint.Rule
+	for _, spec := range ipRulesSpecs {
+		rules = append(rules, &IPRules{
+			resourceType: spec.ResourceType,
+			spec:         spec,
+		})
+	}
+	return rules
+}
+
+// Name returns the rule name
+func (r *IPRules) Name() string {
+	return r.resourceType + "_" + r.attributeName()
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *IPRules) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *IPRules) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *IPRules) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+func (r *IPRules) attributeName() string {
+	return r.spec.AttributePath[len(r.spec.AttributePath)-1]
+}
+
+// Check checks every IP address and CIDR range of the list
+func (r *IPRules) Check(runner tflint.Runner) error {
+	policy, err := decodeIPRangePolicy(runner, r.Name())
+	if err != nil {
+		return err
+	}
+
+	schema := &hclext.BodySchema{Attributes: []hclext.AttributeSchema{{Name: r.attributeName()}}}
+	blockTypes := r.spec.AttributePath[:len(r.spec.AttributePath)-1]
+	for i := len(blockTypes) - 1; i >= 0; i-- {
+		schema = &hclext.BodySchema{Blocks: []hclext.BlockSchema{{Type: blockTypes[i], Body: schema}}}
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, schema, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		blocks := []*hclext.Block{resource}
+		for _, blockType := range blockTypes {
+			var nested []*hclext.Block
+			for _, block := range blocks {
+				nested = append(nested, block.Body.Blocks.OfType(blockType)...)
+			}
+			blocks = nested
+		}
+
+		for _, block := range blocks {
+			attribute, exists := block.Body.Attributes[r.attributeName()]
+			if !exists {
+				continue
+			}
+
+			values, known, err := evaluateStringList(runner, attribute.Expr)
+			if err != nil {
+				return err
+			}
+			if !known {
+				continue
+			}
+
+			for _, value := range values {
+				for _, entry := range strings.Split(value, ",") {
+					// Entries that cannot be parsed, such as empty strings, are not reported
+					ipRange, err := iprange.Parse(entry)
+					if err != nil {
+						continue
+					}
+
+					if message := policy.Violation(ipRange, r.attributeName(), r.spec.AzureServices); message != "" {
+						if err := runner.EmitIssue(r, message, attribute.Range); err != nil {
+							return err
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
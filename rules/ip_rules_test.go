@@ -0,0 +1,176 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_IPRules(t *testing.T) {
+	storageRule := findRule[*IPRules](t, NewIPRulesRules(), "azurerm_storage_account_ip_rules")
+	keyVaultRule := findRule[*IPRules](t, NewIPRulesRules(), "azurerm_key_vault_ip_rules")
+	cosmosRule := findRule[*IPRules](t, NewIPRulesRules(), "azurerm_cosmosdb_account_ip_range_filter")
+
+	tests := []struct {
+		Name     string
+		Rule     *IPRules
+		Config   string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "storage account allowing all IP addresses",
+			Rule: storageRule,
+			Content: `
+resource "azurerm_storage_account" "example" {
+  network_rules {
+    default_action = "Deny"
+    ip_rules       = ["203.0.113.10", "0.0.0.0/0"]
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    storageRule,
+					Message: "ip_rules allows access from all IP addresses (0.0.0.0-255.255.255.255). Consider restricting the IP range for better security.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 5},
+						End:      hcl.Pos{Line: 5, Column: 51},
+					},
+				},
+			},
+		},
+		{
+			Name: "storage account with narrow ranges",
+			Rule: storageRule,
+			Content: `
+resource "azurerm_storage_account" "example" {
+  network_rules {
+    default_action = "Deny"
+    ip_rules       = ["203.0.113.10", "198.51.100.0/24"]
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "key vault with a wide public range",
+			Rule: keyVaultRule,
+			Content: `
+resource "azurerm_key_vault" "example" {
+  network_acls {
+    default_action = "Deny"
+    bypass         = "AzureServices"
+    ip_rules       = ["20.0.0.0/8"]
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    keyVaultRule,
+					Message: "ip_rules range 20.0.0.0-20.255.255.255 holds public IP addresses and is wider than a /16",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 5},
+						End:      hcl.Pos{Line: 6, Column: 36},
+					},
+				},
+			},
+		},
+		{
+			Name: "key vault range outside configured allowed_ranges",
+			Rule: keyVaultRule,
+			Config: `
+rule "azurerm_key_vault_ip_rules" {
+  enabled        = true
+  allowed_ranges = ["203.0.113.0/24"]
+}`,
+			Content: `
+resource "azurerm_key_vault" "example" {
+  network_acls {
+    default_action = "Deny"
+    bypass         = "AzureServices"
+    ip_rules       = ["203.0.113.10", "198.51.100.10"]
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    keyVaultRule,
+					Message: "ip_rules range 198.51.100.10 is not within the allowed ranges (203.0.113.0/24)",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 5},
+						End:      hcl.Pos{Line: 6, Column: 55},
+					},
+				},
+			},
+		},
+		{
+			Name: "Cosmos DB account allowing all Azure services",
+			Rule: cosmosRule,
+			Content: `
+resource "azurerm_cosmosdb_account" "example" {
+  ip_range_filter = ["0.0.0.0", "203.0.113.0/24"]
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    cosmosRule,
+					Message: "ip_range_filter allows access from all Azure services (0.0.0.0), including those of other customers",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 50},
+					},
+				},
+			},
+		},
+		{
+			Name: "Cosmos DB account with a comma-separated filter of azurerm v3",
+			Rule: cosmosRule,
+			Content: `
+resource "azurerm_cosmosdb_account" "example" {
+  ip_range_filter = "203.0.113.0/24,0.0.0.0/1"
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    cosmosRule,
+					Message: "ip_range_filter range 0.0.0.0-127.255.255.255 holds public IP addresses and is wider than a /16",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 47},
+					},
+				},
+			},
+		},
+		{
+			Name: "Cosmos DB account allowing all Azure services by allow_azure_services",
+			Rule: cosmosRule,
+			Config: `
+rule "azurerm_cosmosdb_account_ip_range_filter" {
+  enabled              = true
+  allow_azure_services = true
+}`,
+			Content: `
+resource "azurerm_cosmosdb_account" "example" {
+  ip_range_filter = ["0.0.0.0"]
+}`,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			files := map[string]string{"resource.tf": test.Content}
+			if test.Config != "" {
+				files[".tflint.hcl"] = test.Config
+			}
+			runner := helper.TestRunner(t, files)
+
+			if err := test.Rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
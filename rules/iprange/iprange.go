@@ -0,0 +1,133 @@
+// Package iprange analyses the IP address ranges of firewall rules and network ACLs:
+// their size, whether they contain public addresses and whether they stand for all Azure services.
+package iprange
+
+import (
+	"fmt"
+	"math/big"
+	"net/netip"
+	"strings"
+)
+
+// azureServices is the address of firewall rules allowing access from all Azure services,
+// such as the 0.0.0.0-0.0.0.0 rule of SQL servers or the 0.0.0.0 ip_range_filter of Cosmos DB accounts
+var azureServices = netip.IPv4Unspecified()
+
+// nonPublicPrefixes are the private, shared, loopback and link-local address blocks
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("10.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("127.0.0.0/8"),
+	netip.MustParsePrefix("169.254.0.0/16"),
+	netip.MustParsePrefix("172.16.0.0/12"),
+	netip.MustParsePrefix("192.168.0.0/16"),
+	netip.MustParsePrefix("::1/128"),
+	netip.MustParsePrefix("fc00::/7"),
+	netip.MustParsePrefix("fe80::/10"),
+}
+
+// Range is an inclusive range of IP addresses of the same family
+type Range struct {
+	From netip.Addr
+	To   netip.Addr
+}
+
+// New returns the range between two addresses
+func New(from netip.Addr, to netip.Addr) (Range, error) {
+	if from.Is4() != to.Is4() {
+		return Range{}, fmt.Errorf("%s and %s are not of the same address family", from, to)
+	}
+	if to.Less(from) {
+		return Range{}, fmt.Errorf("%s is lower than %s", to, from)
+	}
+	return Range{From: from, To: to}, nil
+}
+
+// ParseRange parses the range between two addresses, such as the start_ip_address and end_ip_address of a firewall rule
+func ParseRange(from string, to string) (Range, error) {
+	fromAddr, err := netip.ParseAddr(strings.TrimSpace(from))
+	if err != nil {
+		return Range{}, err
+	}
+	toAddr, err := netip.ParseAddr(strings.TrimSpace(to))
+	if err != nil {
+		return Range{}, err
+	}
+	return New(fromAddr, toAddr)
+}
+
+// Parse parses an address or a CIDR range, such as the entries of ip_rules
+func Parse(value string) (Range, error) {
+	value = strings.TrimSpace(value)
+	if !strings.Contains(value, "/") {
+		addr, err := netip.ParseAddr(value)
+		if err != nil {
+			return Range{}, err
+		}
+		return Range{From: addr, To: addr}, nil
+	}
+
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil {
+		return Range{}, err
+	}
+	return FromPrefix(prefix), nil
+}
+
+// FromPrefix returns the range of the addresses of a prefix
+func FromPrefix(prefix netip.Prefix) Range {
+	prefix = prefix.Masked()
+	bytes := prefix.Addr().AsSlice()
+	for bit := prefix.Bits(); bit < len(bytes)*8; bit++ {
+		bytes[bit/8] |= 0x80 >> (bit % 8)
+	}
+	to, _ := netip.AddrFromSlice(bytes)
+	return Range{From: prefix.Addr(), To: to}
+}
+
+// String returns the range as "from-to", or as a single address
+func (r Range) String() string {
+	if r.From == r.To {
+		return r.From.String()
+	}
+	return fmt.Sprintf("%s-%s", r.From, r.To)
+}
+
+// Size returns the number of addresses of the range
+func (r Range) Size() *big.Int {
+	size := new(big.Int).Sub(new(big.Int).SetBytes(r.To.AsSlice()), new(big.Int).SetBytes(r.From.AsSlice()))
+	return size.Add(size, big.NewInt(1))
+}
+
+// PrefixLength returns the length of the longest prefix holding at least as many addresses as the range,
+// such as 16 for 10.0.0.0-10.0.255.255 and 0 for 1.0.0.0-255.255.255.254
+func (r Range) PrefixLength() int {
+	hostBits := new(big.Int).Sub(r.Size(), big.NewInt(1)).BitLen()
+	return r.From.BitLen() - hostBits
+}
+
+// All returns whether the range holds every address of its family, such as 0.0.0.0/0
+func (r Range) All() bool {
+	return r.Size().BitLen()-1 == r.From.BitLen()
+}
+
+// AzureServices returns whether the range is the 0.0.0.0 address allowing access from all Azure services
+func (r Range) AzureServices() bool {
+	return r.From == azureServices && r.To == azureServices
+}
+
+// Public returns whether the range holds public addresses,
+// that is whether it is not contained in a private, shared, loopback or link-local block
+func (r Range) Public() bool {
+	for _, prefix := range nonPublicPrefixes {
+		if r.Within(prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// Within returns whether every address of the range is in the prefix
+func (r Range) Within(prefix netip.Prefix) bool {
+	return prefix.Contains(r.From) && prefix.Contains(r.To)
+}
@@ -0,0 +1,126 @@
+package iprange
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		Value    string
+		Expected string
+		Error    bool
+	}{
+		{Value: "203.0.113.10", Expected: "203.0.113.10"},
+		{Value: "203.0.113.0/24", Expected: "203.0.113.0-203.0.113.255"},
+		{Value: "203.0.113.7/24", Expected: "203.0.113.0-203.0.113.255"},
+		{Value: "0.0.0.0/0", Expected: "0.0.0.0-255.255.255.255"},
+		{Value: "2001:db8::/127", Expected: "2001:db8::-2001:db8::1"},
+		{Value: "203.0.113.0/33", Error: true},
+		{Value: "example.com", Error: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Value, func(t *testing.T) {
+			got, err := Parse(test.Value)
+			if test.Error {
+				if err == nil {
+					t.Errorf("Expected an error, got %s", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+			if got.String() != test.Expected {
+				t.Errorf("Expected %s, got %s", test.Expected, got)
+			}
+		})
+	}
+}
+
+func TestParseRange(t *testing.T) {
+	tests := []struct {
+		Name  string
+		From  string
+		To    string
+		Error bool
+	}{
+		{Name: "ascending", From: "10.0.0.0", To: "10.0.0.255"},
+		{Name: "single address", From: "0.0.0.0", To: "0.0.0.0"},
+		{Name: "descending", From: "10.0.0.255", To: "10.0.0.0", Error: true},
+		{Name: "mixed families", From: "10.0.0.0", To: "::1", Error: true},
+		{Name: "invalid address", From: "10.0.0", To: "10.0.0.255", Error: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			_, err := ParseRange(test.From, test.To)
+			if test.Error && err == nil {
+				t.Error("Expected an error")
+			}
+			if !test.Error && err != nil {
+				t.Errorf("Unexpected error occurred: %s", err)
+			}
+		})
+	}
+}
+
+func TestRange(t *testing.T) {
+	tests := []struct {
+		From          string
+		To            string
+		Size          string
+		PrefixLength  int
+		All           bool
+		AzureServices bool
+		Public        bool
+	}{
+		{From: "0.0.0.0", To: "255.255.255.255", Size: "4294967296", PrefixLength: 0, All: true, Public: true},
+		{From: "1.0.0.0", To: "255.255.255.254", Size: "4278190079", PrefixLength: 0, Public: true},
+		{From: "0.0.0.0", To: "0.0.0.0", Size: "1", PrefixLength: 32, AzureServices: true, Public: true},
+		{From: "203.0.113.0", To: "203.0.113.255", Size: "256", PrefixLength: 24, Public: true},
+		{From: "203.0.113.0", To: "203.0.114.0", Size: "257", PrefixLength: 23, Public: true},
+		{From: "10.0.0.0", To: "10.255.255.255", Size: "16777216", PrefixLength: 8},
+		{From: "192.168.1.10", To: "192.168.1.20", Size: "11", PrefixLength: 28},
+		{From: "10.0.0.0", To: "192.168.0.0", Size: "3064463361", PrefixLength: 0, Public: true},
+		{From: "::", To: "ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff", Size: "340282366920938463463374607431768211456", PrefixLength: 0, All: true, Public: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.From+"-"+test.To, func(t *testing.T) {
+			r, err := ParseRange(test.From, test.To)
+			if err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+			if got := r.Size().String(); got != test.Size {
+				t.Errorf("Expected size %s, got %s", test.Size, got)
+			}
+			if got := r.PrefixLength(); got != test.PrefixLength {
+				t.Errorf("Expected prefix length %d, got %d", test.PrefixLength, got)
+			}
+			if got := r.All(); got != test.All {
+				t.Errorf("Expected All() %t, got %t", test.All, got)
+			}
+			if got := r.AzureServices(); got != test.AzureServices {
+				t.Errorf("Expected AzureServices() %t, got %t", test.AzureServices, got)
+			}
+			if got := r.Public(); got != test.Public {
+				t.Errorf("Expected Public() %t, got %t", test.Public, got)
+			}
+		})
+	}
+}
+
+func TestWithin(t *testing.T) {
+	r, err := ParseRange("203.0.113.10", "203.0.113.20")
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if !r.Within(netip.MustParsePrefix("203.0.113.0/24")) {
+		t.Error("Expected the range to be within 203.0.113.0/24")
+	}
+	if r.Within(netip.MustParsePrefix("203.0.113.16/28")) {
+		t.Error("Expected the range not to be within 203.0.113.16/28")
+	}
+}
@@ -0,0 +1,122 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// kubernetesClusterResourceTypes are the resource types of the AKS cluster rules
+var kubernetesClusterResourceTypes = []string{"azurerm_kubernetes_cluster"}
+
+// kubernetesClusterRuleSpecs declares the attribute rules of the AKS clusters
+var kubernetesClusterRuleSpecs = []*AttributeRuleSpec{
+	{
+		Name:          "local_account_disabled",
+		ResourceTypes: kubernetesClusterResourceTypes,
+		AttributePath: []string{"local_account_disabled"},
+		Type:          cty.Bool,
+		Expected:      []string{"true"},
+		Enabled:       true,
+		Severity:      tflint.WARNING,
+		Messages: AttributeRuleMessages{
+			MissingAttribute: "local_account_disabled is not defined and should be true",
+			InvalidValue:     "local_account_disabled should be true",
+		},
+	},
+	{
+		Name:          "azure_rbac_enabled",
+		ResourceTypes: kubernetesClusterResourceTypes,
+		AttributePath: []string{"azure_active_directory_role_based_access_control", "azure_rbac_enabled"},
+		Type:          cty.Bool,
+		Expected:      []string{"true"},
+		Enabled:       true,
+		Severity:      tflint.WARNING,
+		Messages: AttributeRuleMessages{
+			MissingBlock:     "azure_active_directory_role_based_access_control block is missing, azure_rbac_enabled should be true",
+			MissingAttribute: "azure_rbac_enabled is missing in azure_active_directory_role_based_access_control, should be true",
+			InvalidValue:     "azure_rbac_enabled should be true",
+		},
+	},
+	{
+		Name:          "network_policy",
+		ResourceTypes: kubernetesClusterResourceTypes,
+		AttributePath: []string{"network_profile", "network_policy"},
+		Type:          cty.String,
+		Expected:      []string{"azure", "calico", "cilium"},
+		Enabled:       true,
+		Severity:      tflint.WARNING,
+		Messages: AttributeRuleMessages{
+			MissingBlock:     "network_profile block is missing, network_policy should be set to {expected}",
+			MissingAttribute: "network_policy is missing in network_profile, should be set to {expected}",
+			InvalidValue:     "network_policy is set to {value}, should be set to {expected}",
+		},
+		// network_plugin is required in network_profile
+		NoBlockFix: true,
+	},
+	{
+		Name:          "azure_policy_enabled",
+		ResourceTypes: kubernetesClusterResourceTypes,
+		AttributePath: []string{"azure_policy_enabled"},
+		Type:          cty.Bool,
+		Expected:      []string{"true"},
+		Enabled:       true,
+		Severity:      tflint.NOTICE,
+		Messages: AttributeRuleMessages{
+			MissingAttribute: "azure_policy_enabled is not defined and should be true",
+			InvalidValue:     "azure_policy_enabled should be true",
+		},
+	},
+	{
+		Name:          "workload_identity_enabled",
+		ResourceTypes: kubernetesClusterResourceTypes,
+		AttributePath: []string{"workload_identity_enabled"},
+		Type:          cty.Bool,
+		Expected:      []string{"true"},
+		Enabled:       true,
+		Severity:      tflint.NOTICE,
+		Messages: AttributeRuleMessages{
+			MissingAttribute: "workload_identity_enabled is not defined and should be true",
+			InvalidValue:     "workload_identity_enabled should be true",
+		},
+	},
+	{
+		Name:          "oidc_issuer_enabled",
+		ResourceTypes: kubernetesClusterResourceTypes,
+		AttributePath: []string{"oidc_issuer_enabled"},
+		Type:          cty.Bool,
+		Expected:      []string{"true"},
+		Enabled:       true,
+		Severity:      tflint.NOTICE,
+		Messages: AttributeRuleMessages{
+			MissingAttribute: "oidc_issuer_enabled is not defined and should be true, workload identity requires the OIDC issuer",
+			InvalidValue:     "oidc_issuer_enabled should be true, workload identity requires the OIDC issuer",
+		},
+		Condition: &AttributeRuleCondition{Attribute: "workload_identity_enabled", Values: []string{"true"}},
+	},
+	{
+		Name:          "automatic_upgrade_channel",
+		ResourceTypes: kubernetesClusterResourceTypes,
+		AttributePath: []string{"automatic_upgrade_channel"},
+		Type:          cty.String,
+		Expected:      []string{"patch", "rapid", "node-image", "stable"},
+		Enabled:       true,
+		Severity:      tflint.NOTICE,
+		Messages: AttributeRuleMessages{
+			MissingAttribute: "{attribute} is not defined and should be set to {expected}",
+			InvalidValue:     "{attribute} is set to {value}, should be set to {expected}",
+		},
+	},
+	{
+		Name:          "run_command_enabled",
+		ResourceTypes: kubernetesClusterResourceTypes,
+		AttributePath: []string{"run_command_enabled"},
+		Type:          cty.Bool,
+		Expected:      []string{"false"},
+		Enabled:       true,
+		Severity:      tflint.WARNING,
+		Messages: AttributeRuleMessages{
+			MissingAttribute: "run_command_enabled is not defined and should be false",
+			InvalidValue:     "run_command_enabled should be false",
+		},
+	},
+}
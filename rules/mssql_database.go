@@ -0,0 +1,26 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// mssqlDatabaseRuleSpecs declares the attribute rules of the SQL databases
+var mssqlDatabaseRuleSpecs = []*AttributeRuleSpec{
+	{
+		Name:          "ledger_enabled",
+		ResourceTypes: []string{"azurerm_mssql_database"},
+		AttributePath: []string{"ledger_enabled"},
+		Type:          cty.Bool,
+		Expected:      []string{"true"},
+		// The ledger cannot be disabled once enabled, nor enabled on an existing database
+		Enabled:  false,
+		Severity: tflint.NOTICE,
+		Messages: AttributeRuleMessages{
+			MissingAttribute: "ledger_enabled is not defined and should be true to make the database tamper-evident",
+			InvalidValue:     "ledger_enabled should be true to make the database tamper-evident",
+		},
+		// Changing ledger_enabled recreates the database
+		NoFix: true,
+	},
+}
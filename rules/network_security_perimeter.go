@@ -0,0 +1,48 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// networkSecurityPerimeterAssociation attaches resources to a network security perimeter through their ID
+var networkSecurityPerimeterAssociation = association{
+	ResourceType: "azurerm_network_security_perimeter_association",
+	Path:         []string{"resource_id"},
+}
+
+// checkNetworkSecurityPerimeterAssociation reports the resources of the given type that are not
+// the resource_id of an azurerm_network_security_perimeter_association.
+// title is the name of the resource type used in the issue message, such as "Key Vault".
+func checkNetworkSecurityPerimeterAssociation(runner tflint.Runner, rule tflint.Rule, resourceType string, title string) error {
+	return checkAssociated(runner, rule, resourceType, networkSecurityPerimeterAssociation, func(name string) string {
+		return fmt.Sprintf("%s '%s' does not have an associated azurerm_network_security_perimeter_association", title, name)
+	})
+}
+
+// checkAssociated reports the resources of the given type that are not referenced by any association.
+// message returns the issue message for the name of the resource.
+func checkAssociated(runner tflint.Runner, rule tflint.Rule, resourceType string, assoc association, message func(name string) string) error {
+	resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	associated, err := newAssociationIndex(runner, assoc, resourceType)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		if len(resource.Labels) < 2 || len(associated[resource.Labels[1]]) > 0 {
+			continue
+		}
+		if err := runner.EmitIssue(rule, message(resource.Labels[1]), resource.DefRange); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,256 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/rules/iprange"
+)
+
+// securityRuleAttributes are the attributes of azurerm_network_security_rule
+// and of the security_rule blocks of azurerm_network_security_group
+var securityRuleAttributes = []hclext.AttributeSchema{
+	{Name: "name"},
+	{Name: "direction"},
+	{Name: "access"},
+	{Name: "protocol"},
+	{Name: "source_address_prefix"},
+	{Name: "source_address_prefixes"},
+	{Name: "destination_port_range"},
+	{Name: "destination_port_ranges"},
+}
+
+// internetSources are the source address prefixes and service tags matching any address of the internet
+var internetSources = []string{"*", "Any", "Internet"}
+
+// portlessProtocols are the protocols of security rules that do not open ports
+var portlessProtocols = []string{"Icmp", "Ah", "Esp"}
+
+// service is a well-known port and the name of the service listening on it
+type service struct {
+	Port int
+	Name string
+}
+
+// portClass is a group of ports whose exposure to the internet is reported with the same severity
+type portClass struct {
+	Name     string
+	Severity tflint.Severity
+	Services []service
+}
+
+// portClasses are the port classes reported by the open port rules, from the most to the least severe
+var portClasses = []portClass{
+	{
+		Name:     "management",
+		Severity: tflint.ERROR,
+		Services: []service{
+			{Port: 22, Name: "SSH"},
+			{Port: 3389, Name: "RDP"},
+			{Port: 5985, Name: "WinRM"},
+			{Port: 5986, Name: "WinRM over HTTPS"},
+		},
+	},
+	{
+		Name:     "database",
+		Severity: tflint.WARNING,
+		Services: []service{
+			{Port: 1433, Name: "SQL Server"},
+			{Port: 1521, Name: "Oracle"},
+			{Port: 3306, Name: "MySQL"},
+			{Port: 5432, Name: "PostgreSQL"},
+			{Port: 6379, Name: "Redis"},
+			{Port: 9042, Name: "Cassandra"},
+			{Port: 27017, Name: "MongoDB"},
+		},
+	},
+}
+
+// portRange is an inclusive range of ports
+type portRange struct {
+	From int
+	To   int
+}
+
+// allPorts is the range of the "*" destination port range
+var allPorts = portRange{From: 0, To: 65535}
+
+// parsePortRange parses a destination port range such as "22", "1000-4000" or "*"
+func parsePortRange(value string) (portRange, error) {
+	value = strings.TrimSpace(value)
+	if value == "*" {
+		return allPorts, nil
+	}
+
+	from, to, isRange := strings.Cut(value, "-")
+	if !isRange {
+		to = from
+	}
+	fromPort, err := strconv.Atoi(strings.TrimSpace(from))
+	if err != nil {
+		return portRange{}, fmt.Errorf("invalid port range %q", value)
+	}
+	toPort, err := strconv.Atoi(strings.TrimSpace(to))
+	if err != nil {
+		return portRange{}, fmt.Errorf("invalid port range %q", value)
+	}
+	if fromPort < allPorts.From || toPort > allPorts.To || fromPort > toPort {
+		return portRange{}, fmt.Errorf("invalid port range %q", value)
+	}
+	return portRange{From: fromPort, To: toPort}, nil
+}
+
+// Contains returns whether the port is in the range
+func (r portRange) Contains(port int) bool {
+	return r.From <= port && port <= r.To
+}
+
+// isInternetSource returns whether a source address prefix matches any address of the internet,
+// either as a service tag or as a CIDR range such as 0.0.0.0/0
+func isInternetSource(value string) bool {
+	value = strings.TrimSpace(value)
+	if slices.ContainsFunc(internetSources, func(source string) bool { return strings.EqualFold(source, value) }) {
+		return true
+	}
+	ipRange, err := iprange.Parse(value)
+	return err == nil && ipRange.All()
+}
+
+// openPortIssue is the exposure of a port class by a security rule
+type openPortIssue struct {
+	Class  portClass
+	Source string
+	// Services are the services of the class in the destination port ranges, empty when every port is open
+	Services []service
+}
+
+// Message returns the issue message for the given security rule description
+func (i openPortIssue) Message(description string) string {
+	if len(i.Services) == 0 {
+		return fmt.Sprintf("%s allows inbound traffic from %s to all ports, including the %s ports", description, i.Source, i.Class.Name)
+	}
+	ports := make([]string, 0, len(i.Services))
+	for _, service := range i.Services {
+		ports = append(ports, fmt.Sprintf("%d (%s)", service.Port, service.Name))
+	}
+	noun := "port"
+	if len(ports) > 1 {
+		noun = "ports"
+	}
+	return fmt.Sprintf("%s allows inbound traffic from %s to the %s %s %s", description, i.Source, i.Class.Name, noun, strings.Join(ports, ", "))
+}
+
+// openPortIssues returns the port classes exposed to the internet by an inbound allow security rule.
+// Attributes that cannot be evaluated, such as unknown variables, are not reported.
+func openPortIssues(runner tflint.Runner, attributes hclext.Attributes) ([]openPortIssue, error) {
+	for name, expected := range map[string]string{"direction": "Inbound", "access": "Allow"} {
+		values, known, err := evaluateSecurityRuleAttribute(runner, attributes, name)
+		if err != nil || !known || len(values) != 1 || !strings.EqualFold(values[0], expected) {
+			return nil, err
+		}
+	}
+
+	protocols, known, err := evaluateSecurityRuleAttribute(runner, attributes, "protocol")
+	if err != nil || !known {
+		return nil, err
+	}
+	if len(protocols) == 1 && slices.ContainsFunc(portlessProtocols, func(protocol string) bool { return strings.EqualFold(protocol, protocols[0]) }) {
+		return nil, nil
+	}
+
+	var source string
+	for _, name := range []string{"source_address_prefix", "source_address_prefixes"} {
+		values, _, err := evaluateSecurityRuleAttribute(runner, attributes, name)
+		if err != nil {
+			return nil, err
+		}
+		if i := slices.IndexFunc(values, isInternetSource); i >= 0 {
+			source = values[i]
+			break
+		}
+	}
+	if source == "" {
+		return nil, nil
+	}
+
+	var ranges []portRange
+	for _, name := range []string{"destination_port_range", "destination_port_ranges"} {
+		values, _, err := evaluateSecurityRuleAttribute(runner, attributes, name)
+		if err != nil {
+			return nil, err
+		}
+		for _, value := range values {
+			if r, err := parsePortRange(value); err == nil {
+				ranges = append(ranges, r)
+			}
+		}
+	}
+
+	var issues []openPortIssue
+	for _, class := range portClasses {
+		if slices.Contains(ranges, allPorts) {
+			issues = append(issues, openPortIssue{Class: class, Source: source})
+			continue
+		}
+		var services []service
+		for _, service := range class.Services {
+			if slices.ContainsFunc(ranges, func(r portRange) bool { return r.Contains(service.Port) }) {
+				services = append(services, service)
+			}
+		}
+		if len(services) > 0 {
+			issues = append(issues, openPortIssue{Class: class, Source: source, Services: services})
+		}
+	}
+	return issues, nil
+}
+
+// evaluateSecurityRuleAttribute evaluates a string or list attribute of a security rule.
+// known is false when the attribute is missing or cannot be evaluated.
+func evaluateSecurityRuleAttribute(runner tflint.Runner, attributes hclext.Attributes, name string) ([]string, bool, error) {
+	attribute, exists := attributes[name]
+	if !exists {
+		return nil, false, nil
+	}
+	return evaluateStringList(runner, attribute.Expr)
+}
+
+// emitOpenPortIssues reports the port classes exposed by a security rule, each with the severity of its class
+func emitOpenPortIssues(runner tflint.Runner, rule tflint.Rule, attributes hclext.Attributes, description string, issueRange hcl.Range) error {
+	issues, err := openPortIssues(runner, attributes)
+	if err != nil {
+		return err
+	}
+	for _, issue := range issues {
+		if err := runner.EmitIssue(withSeverity(rule, issue.Class.Severity), issue.Message(description), issueRange); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// severityRule reports the issues of a rule with another severity, such as that of a port class
+type severityRule struct {
+	tflint.Rule
+
+	severity tflint.Severity
+}
+
+// Severity returns the severity of the issue
+func (r *severityRule) Severity() tflint.Severity {
+	return r.severity
+}
+
+// withSeverity returns the rule, wrapped when the severity differs from its own
+func withSeverity(rule tflint.Rule, severity tflint.Severity) tflint.Rule {
+	if rule.Severity() == severity {
+		return rule
+	}
+	return &severityRule{Rule: rule, severity: severity}
+}
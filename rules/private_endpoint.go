@@ -0,0 +1,176 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+)
+
+// privateEndpointConnection attaches private endpoints to resources through their private_service_connection
+var privateEndpointConnection = association{
+	ResourceType: "azurerm_private_endpoint",
+	Path:         []string{"private_service_connection", "private_connection_resource_id"},
+	Attributes:   []string{"subresource_names"},
+}
+
+// privateEndpointSpec declares a resource type that must be reached through private endpoints
+type privateEndpointSpec struct {
+	ResourceType string
+	// Title is the name of the resource type used in issue messages
+	Title string
+	// Subresources are the subresource names the private endpoints of a resource must cover together.
+	// A group is covered by any of its names, such as the API of a Cosmos DB account.
+	Subresources [][]string
+}
+
+// privateEndpointSpecs are the resource types checked by the private endpoint rules
+var privateEndpointSpecs = []privateEndpointSpec{
+	{ResourceType: "azurerm_container_registry", Title: "Container Registry", Subresources: [][]string{{"registry"}}},
+	{ResourceType: "azurerm_cosmosdb_account", Title: "Cosmos DB Account", Subresources: [][]string{{"Sql", "MongoDB", "Cassandra", "Gremlin", "Table"}}},
+	{ResourceType: "azurerm_eventhub_namespace", Title: "EventHub Namespace", Subresources: [][]string{{"namespace"}}},
+	{ResourceType: "azurerm_key_vault", Title: "Key Vault", Subresources: [][]string{{"vault"}}},
+	{ResourceType: "azurerm_linux_web_app", Title: "Linux Web App", Subresources: [][]string{{"sites"}}},
+	{ResourceType: "azurerm_mssql_server", Title: "SQL Server", Subresources: [][]string{{"sqlServer"}}},
+	{ResourceType: "azurerm_servicebus_namespace", Title: "Service Bus Namespace", Subresources: [][]string{{"namespace"}}},
+	{ResourceType: "azurerm_storage_account", Title: "Storage Account", Subresources: [][]string{{"blob"}, {"file"}}},
+	{ResourceType: "azurerm_windows_web_app", Title: "Windows Web App", Subresources: [][]string{{"sites"}}},
+}
+
+// PrivateEndpoint checks that resources are the target of private endpoints covering their subresources
+type PrivateEndpoint struct {
+	tflint.DefaultRule
+
+	resourceType string
+	spec         privateEndpointSpec
+}
+
+// NewPrivateEndpointRules returns a rule instance for every resource type that must be reached through private endpoints
+func NewPrivateEndpointRules() []tflint.Rule {
+	var rules []tflint.Rule
+	for _, spec := range privateEndpointSpecs {
+		rules = append(rules, &PrivateEndpoint{
+			resourceType: spec.ResourceType,
+			spec:         spec,
+		})
+	}
+	return rules
+}
+
+// Name returns the rule name
+func (r *PrivateEndpoint) Name() string {
+	return r.resourceType + "_private_endpoint"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *PrivateEndpoint) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *PrivateEndpoint) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *PrivateEndpoint) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks that every resource is referenced by the private_service_connection of an azurerm_private_endpoint
+// and that the subresource_names of those private endpoints cover the subresources of the resource
+func (r *PrivateEndpoint) Check(runner tflint.Runner) error {
+	subresources := r.spec.Subresources
+	config := privateEndpointRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+	if len(config.SubresourceNames) > 0 {
+		subresources = nil
+		for _, name := range config.SubresourceNames {
+			subresources = append(subresources, []string{name})
+		}
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	connections, err := newAssociationIndex(runner, privateEndpointConnection, r.resourceType)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		if len(resource.Labels) < 2 {
+			continue
+		}
+		name := resource.Labels[1]
+
+		if len(connections[name]) == 0 {
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("%s '%s' is not the private_connection_resource_id of any azurerm_private_endpoint", r.spec.Title, name),
+				resource.DefRange,
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		covered, known, err := r.coveredSubresources(runner, connections[name])
+		if err != nil {
+			return err
+		}
+		if !known {
+			continue
+		}
+
+		var missing []string
+		for _, group := range subresources {
+			if !slices.ContainsFunc(group, func(subresource string) bool { return covered[strings.ToLower(subresource)] }) {
+				missing = append(missing, strings.Join(group, " or "))
+			}
+		}
+		if len(missing) > 0 {
+			noun := "subresource"
+			if len(missing) > 1 {
+				noun = "subresources"
+			}
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("%s '%s' has no private endpoint for the %s %s", r.spec.Title, name, strings.Join(missing, ", "), noun),
+				resource.DefRange,
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// coveredSubresources returns the lower-cased subresource names of the private service connections.
+// known is false when a name cannot be evaluated, such as one taken from each.value.
+func (r *PrivateEndpoint) coveredSubresources(runner tflint.Runner, connections []*hclext.Block) (map[string]bool, bool, error) {
+	covered := map[string]bool{}
+	for _, connection := range connections {
+		attribute, exists := connection.Body.Attributes["subresource_names"]
+		if !exists {
+			continue
+		}
+		names, known, err := evaluateStrings(runner, attribute.Expr)
+		if err != nil || !known {
+			return nil, false, err
+		}
+		for _, name := range names {
+			covered[strings.ToLower(name)] = true
+		}
+	}
+	return covered, true, nil
+}
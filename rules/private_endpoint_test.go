@@ -0,0 +1,229 @@
+package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_PrivateEndpoint(t *testing.T) {
+	storageRule := findRule[*PrivateEndpoint](t, NewPrivateEndpointRules(), "azurerm_storage_account_private_endpoint")
+	cosmosRule := findRule[*PrivateEndpoint](t, NewPrivateEndpointRules(), "azurerm_cosmosdb_account_private_endpoint")
+
+	storageDefRange := hcl.Range{
+		Filename: "resource.tf",
+		Start:    hcl.Pos{Line: 2, Column: 1},
+		End:      hcl.Pos{Line: 2, Column: 45},
+	}
+
+	tests := []struct {
+		Name     string
+		Rule     *PrivateEndpoint
+		Config   string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "no private endpoint",
+			Rule: storageRule,
+			Content: `
+resource "azurerm_storage_account" "example" {
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    storageRule,
+					Message: "Storage Account 'example' is not the private_connection_resource_id of any azurerm_private_endpoint",
+					Range:   storageDefRange,
+				},
+			},
+		},
+		{
+			Name: "private endpoint missing a subresource",
+			Rule: storageRule,
+			Content: `
+resource "azurerm_storage_account" "example" {
+}
+
+resource "azurerm_private_endpoint" "blob" {
+  private_service_connection {
+    private_connection_resource_id = azurerm_storage_account.example.id
+    subresource_names              = ["blob"]
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    storageRule,
+					Message: "Storage Account 'example' has no private endpoint for the file subresource",
+					Range:   storageDefRange,
+				},
+			},
+		},
+		{
+			Name: "private endpoint without subresource names",
+			Rule: storageRule,
+			Content: `
+resource "azurerm_storage_account" "example" {
+}
+
+resource "azurerm_private_endpoint" "example" {
+  private_service_connection {
+    private_connection_resource_id = azurerm_storage_account.example.id
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    storageRule,
+					Message: "Storage Account 'example' has no private endpoint for the blob, file subresources",
+					Range:   storageDefRange,
+				},
+			},
+		},
+		{
+			Name: "private endpoints covering every subresource",
+			Rule: storageRule,
+			Content: `
+resource "azurerm_storage_account" "example" {
+}
+
+resource "azurerm_private_endpoint" "blob" {
+  private_service_connection {
+    private_connection_resource_id = azurerm_storage_account.example.id
+    subresource_names              = ["blob"]
+  }
+}
+
+resource "azurerm_private_endpoint" "file" {
+  private_service_connection {
+    private_connection_resource_id = azurerm_storage_account.example.id
+    subresource_names              = ["file"]
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "private endpoints created with for_each",
+			Rule: storageRule,
+			Content: `
+resource "azurerm_storage_account" "example" {
+}
+
+resource "azurerm_private_endpoint" "example" {
+  for_each = toset(["blob", "file"])
+
+  private_service_connection {
+    private_connection_resource_id = azurerm_storage_account.example.id
+    subresource_names              = [each.value]
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "private endpoint of another storage account",
+			Rule: storageRule,
+			Content: `
+resource "azurerm_storage_account" "example" {
+}
+
+resource "azurerm_storage_account" "other" {
+}
+
+resource "azurerm_private_endpoint" "other" {
+  private_service_connection {
+    private_connection_resource_id = azurerm_storage_account.other.id
+    subresource_names              = ["blob", "file"]
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    storageRule,
+					Message: "Storage Account 'example' is not the private_connection_resource_id of any azurerm_private_endpoint",
+					Range:   storageDefRange,
+				},
+			},
+		},
+		{
+			Name: "subresource names set in the rule block",
+			Rule: storageRule,
+			Config: `
+rule "azurerm_storage_account_private_endpoint" {
+  enabled           = true
+  subresource_names = ["blob", "dfs"]
+}`,
+			Content: `
+resource "azurerm_storage_account" "example" {
+}
+
+resource "azurerm_private_endpoint" "blob" {
+  private_service_connection {
+    private_connection_resource_id = azurerm_storage_account.example.id
+    subresource_names              = ["blob"]
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    storageRule,
+					Message: "Storage Account 'example' has no private endpoint for the dfs subresource",
+					Range:   storageDefRange,
+				},
+			},
+		},
+		{
+			Name: "Cosmos DB account reached through its API",
+			Rule: cosmosRule,
+			Content: `
+resource "azurerm_cosmosdb_account" "example" {
+  kind = "MongoDB"
+}
+
+resource "azurerm_private_endpoint" "example" {
+  private_service_connection {
+    private_connection_resource_id = azurerm_cosmosdb_account.example.id
+    subresource_names              = ["MongoDB"]
+  }
+}`,
+			Expected: helper.Issues{},
+		},
+		{
+			Name: "Cosmos DB account without API subresource",
+			Rule: cosmosRule,
+			Content: `
+resource "azurerm_cosmosdb_account" "example" {
+}
+
+resource "azurerm_private_endpoint" "example" {
+  private_service_connection {
+    private_connection_resource_id = azurerm_cosmosdb_account.example.id
+    subresource_names              = ["Analytical"]
+  }
+}`,
+			Expected: helper.Issues{
+				{
+					Rule:    cosmosRule,
+					Message: "Cosmos DB Account 'example' has no private endpoint for the Sql or MongoDB or Cassandra or Gremlin or Table subresource",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 46},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			files := map[string]string{"resource.tf": test.Content}
+			if test.Config != "" {
+				files[".tflint.hcl"] = test.Config
+			}
+			runner := helper.TestRunner(t, files)
+
+			if err := test.Rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
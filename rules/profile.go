@@ -0,0 +1,128 @@
+package rules
+
+import (
+	"path"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// Profile is a named set of overrides of the rule defaults, selected with `profile` in the plugin block
+type Profile struct {
+	Name        string
+	Description string
+	// Extends is the name of the profile whose overrides apply first
+	Extends string
+	// MinimumTLSVersion is the minimum TLS version used when the plugin block does not set one
+	MinimumTLSVersion string
+	// EnableDisabledRules turns on the rules that are disabled by default, except the opt-in rules
+	// listed in the Enable patterns of a profile
+	EnableDisabledRules bool
+	// Enable lists the rules enabled by the profile, either by name or as a path.Match pattern
+	Enable []string
+	// Severities raises the severity of every rule from the key to the value
+	Severities map[tflint.Severity]tflint.Severity
+}
+
+// Profiles are the security profiles that can be selected in the plugin block
+var Profiles = []*Profile{
+	{
+		Name:              "baseline",
+		Description:       "The default rule set: every rule keeps its own enabled state and severity.",
+		MinimumTLSVersion: "1.2",
+	},
+	{
+		Name:                "strict",
+		Description:         "Turns on the rules that are disabled by default, except the opt-in rules of the regulated profile, raises severities and requires TLS 1.3 where supported.",
+		Extends:             "baseline",
+		MinimumTLSVersion:   "1.3",
+		EnableDisabledRules: true,
+		Severities: map[tflint.Severity]tflint.Severity{
+			tflint.NOTICE:  tflint.WARNING,
+			tflint.WARNING: tflint.ERROR,
+		},
+	},
+	{
+		Name:        "regulated",
+		Description: "Strict, and also requires customer-managed keys, private endpoints and diagnostic settings.",
+		Extends:     "strict",
+		Enable: []string{
+			"*_customer_managed_key",
+			"*_private_endpoint",
+			"*_diagnostic_setting",
+		},
+	},
+}
+
+// LookupProfile returns the profile of the given name
+func LookupProfile(name string) (*Profile, bool) {
+	for _, profile := range Profiles {
+		if profile.Name == name {
+			return profile, true
+		}
+	}
+	return nil, false
+}
+
+// Parent returns the profile extended by the profile, or nil
+func (p *Profile) Parent() *Profile {
+	if p.Extends == "" {
+		return nil
+	}
+	parent, _ := LookupProfile(p.Extends)
+	return parent
+}
+
+// TLSVersion returns the minimum TLS version of the profile, inherited from the extended profile when not set
+func (p *Profile) TLSVersion() string {
+	for profile := p; profile != nil; profile = profile.Parent() {
+		if profile.MinimumTLSVersion != "" {
+			return profile.MinimumTLSVersion
+		}
+	}
+	return DefaultMinimumTLSVersion
+}
+
+// RuleEnabled returns whether the rule is enabled by default under the profile
+func (p *Profile) RuleEnabled(rule tflint.Rule) bool {
+	for profile := p; profile != nil; profile = profile.Parent() {
+		if profile.enables(rule.Name()) {
+			return true
+		}
+		if profile.EnableDisabledRules && !optInRule(rule.Name()) {
+			return true
+		}
+	}
+	return rule.Enabled()
+}
+
+// RuleSeverity returns the severity of the rule under the profile
+func (p *Profile) RuleSeverity(rule tflint.Rule) tflint.Severity {
+	severity := rule.Severity()
+	if parent := p.Parent(); parent != nil {
+		severity = parent.RuleSeverity(rule)
+	}
+	if raised, ok := p.Severities[severity]; ok {
+		return raised
+	}
+	return severity
+}
+
+// optInRule returns whether the rule is enabled by name or pattern by a profile,
+// so that it is not turned on by profiles enabling the rules disabled by default
+func optInRule(name string) bool {
+	for _, profile := range Profiles {
+		if profile.enables(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Profile) enables(name string) bool {
+	for _, pattern := range p.Enable {
+		if matched, _ := path.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
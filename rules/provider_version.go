@@ -0,0 +1,316 @@
+package rules
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// defaultAzurermMajorVersion is the azurerm major version assumed when neither the lock file
+// nor the required_providers block constrain it
+const defaultAzurermMajorVersion = 4
+
+// lockFileName is the dependency lock file written by terraform init
+const lockFileName = ".terraform.lock.hcl"
+
+// azurermProviderSource is the registry source of the azurerm provider, without the registry host
+const azurermProviderSource = "hashicorp/azurerm"
+
+// azurermAttributeRenames maps the attributes renamed in azurerm v4 to their azurerm v3 name, per resource type
+var azurermAttributeRenames = map[string]map[string]string{
+	"azurerm_cosmosdb_account": {
+		"automatic_failover_enabled":       "enable_automatic_failover",
+		"free_tier_enabled":                "enable_free_tier",
+		"multiple_write_locations_enabled": "enable_multiple_write_locations",
+	},
+	"azurerm_kubernetes_cluster": {
+		"automatic_upgrade_channel": "automatic_channel_upgrade",
+		"node_os_upgrade_channel":   "node_os_channel_upgrade",
+	},
+	"azurerm_kubernetes_cluster_node_pool": {
+		"auto_scaling_enabled":    "enable_auto_scaling",
+		"host_encryption_enabled": "enable_host_encryption",
+		"node_public_ip_enabled":  "enable_node_public_ip",
+	},
+	"azurerm_network_interface": {
+		"accelerated_networking_enabled": "enable_accelerated_networking",
+		"ip_forwarding_enabled":          "enable_ip_forwarding",
+	},
+	"azurerm_redis_cache": {
+		"non_ssl_port_enabled": "enable_non_ssl_port",
+	},
+	"azurerm_storage_account": {
+		"https_traffic_only_enabled": "enable_https_traffic_only",
+	},
+}
+
+// versionedAttribute is an attribute whose name depends on the azurerm major version
+type versionedAttribute struct {
+	// Name is the attribute name in the azurerm major version of the configuration
+	Name string
+	// OtherName is the attribute name in the other azurerm major version, or empty when it was not renamed
+	OtherName    string
+	MajorVersion int
+}
+
+// newVersionedAttribute returns the attribute of the resource type for the azurerm major version of the configuration.
+// name is the azurerm v4 name of the attribute.
+func newVersionedAttribute(runner tflint.Runner, resourceType string, name string) (*versionedAttribute, error) {
+	major, err := azurermMajorVersion(runner)
+	if err != nil {
+		return nil, err
+	}
+
+	attribute := &versionedAttribute{Name: name, MajorVersion: major}
+	v3Name, renamed := azurermAttributeRenames[resourceType][name]
+	if !renamed {
+		return attribute, nil
+	}
+	if major < 4 {
+		attribute.Name, attribute.OtherName = v3Name, name
+	} else {
+		attribute.OtherName = v3Name
+	}
+	return attribute, nil
+}
+
+// Schema returns the attribute schemas of both names, so that names of the other major version are found
+func (a *versionedAttribute) Schema() []hclext.AttributeSchema {
+	schema := []hclext.AttributeSchema{{Name: a.Name}}
+	if a.OtherName != "" {
+		schema = append(schema, hclext.AttributeSchema{Name: a.OtherName})
+	}
+	return schema
+}
+
+// Lookup returns the attribute of the resource, reporting the use of the name of the other major version.
+// The attribute set with the other name is returned when the resource does not set the expected one,
+// so that its value is still checked.
+func (a *versionedAttribute) Lookup(runner tflint.Runner, rule tflint.Rule, resource *hclext.Block) (*hclext.Attribute, bool, error) {
+	attribute, exists := resource.Body.Attributes[a.Name]
+	if a.OtherName == "" {
+		return attribute, exists, nil
+	}
+	other, otherExists := resource.Body.Attributes[a.OtherName]
+	if !otherExists {
+		return attribute, exists, nil
+	}
+
+	message := fmt.Sprintf("%s is the azurerm v%d name of %s, the configuration uses azurerm v%d", a.OtherName, a.otherMajorVersion(), a.Name, a.MajorVersion)
+	if exists {
+		// Renaming would set the attribute twice, the duplicate has to be removed by hand
+		if err := runner.EmitIssue(rule, message, other.NameRange); err != nil {
+			return nil, false, err
+		}
+		return attribute, true, nil
+	}
+	if err := runner.EmitIssueWithFix(rule, message, other.NameRange, fixRenameAttribute(other, a.Name)); err != nil {
+		return nil, false, err
+	}
+	return other, true, nil
+}
+
+func (a *versionedAttribute) otherMajorVersion() int {
+	if a.MajorVersion < 4 {
+		return 4
+	}
+	return 3
+}
+
+// azurermMajorVersion returns the azurerm major version of the configuration.
+// The version locked in .terraform.lock.hcl takes precedence over the required_providers constraint.
+func azurermMajorVersion(runner tflint.Runner) (int, error) {
+	lockFile, err := readLockFile(runner)
+	if err != nil {
+		return 0, err
+	}
+	if lockFile != nil {
+		if locked, ok := lockedAzurermVersion(lockFile); ok {
+			return locked.Segments()[0], nil
+		}
+	}
+
+	constraints, err := requiredAzurermVersion(runner)
+	if err != nil {
+		return 0, err
+	}
+	if constraints != nil {
+		if newest := newestAllowedVersion(constraints); newest != nil {
+			return newest.Segments()[0], nil
+		}
+	}
+	return defaultAzurermMajorVersion, nil
+}
+
+// readLockFile returns the dependency lock file of the module directory, or nil when there is none
+func readLockFile(runner tflint.Runner) (*hcl.File, error) {
+	name, err := lockFilePath(runner)
+	if err != nil {
+		return nil, err
+	}
+	if file, err := runner.GetFile(name); err == nil && file != nil {
+		return file, nil
+	}
+
+	wd, err := runner.GetOriginalwd()
+	if err != nil {
+		return nil, err
+	}
+	path := name
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(wd, path)
+	}
+	src, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	file, diags := hclparse.NewParser().ParseHCL(src, name)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	return file, nil
+}
+
+// lockFilePath returns the path of the lock file in the directory of the module files.
+// File names are relative to the original working directory, which is not the working directory
+// of the module with --chdir or --recursive.
+func lockFilePath(runner tflint.Runner) (string, error) {
+	files, err := runner.GetFiles()
+	if err != nil {
+		return "", err
+	}
+
+	var names []string
+	for name := range files {
+		if strings.HasSuffix(name, ".tf") || strings.HasSuffix(name, ".tf.json") {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return lockFileName, nil
+	}
+	return filepath.Join(filepath.Dir(slices.Min(names)), lockFileName), nil
+}
+
+// lockedAzurermVersion returns the azurerm version selected in the lock file
+func lockedAzurermVersion(file *hcl.File) (*version.Version, bool) {
+	content, _, diags := file.Body.PartialContent(&hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{{Type: "provider", LabelNames: []string{"source"}}},
+	})
+	if diags.HasErrors() {
+		return nil, false
+	}
+
+	for _, block := range content.Blocks {
+		if !isAzurermSource(block.Labels[0]) {
+			continue
+		}
+		attributes, _, diags := block.Body.PartialContent(&hcl.BodySchema{
+			Attributes: []hcl.AttributeSchema{{Name: "version"}},
+		})
+		if diags.HasErrors() || attributes.Attributes["version"] == nil {
+			continue
+		}
+		value, diags := attributes.Attributes["version"].Expr.Value(nil)
+		if diags.HasErrors() || value.Type() != cty.String || value.IsNull() {
+			continue
+		}
+		locked, err := version.NewVersion(value.AsString())
+		if err != nil {
+			continue
+		}
+		return locked, true
+	}
+	return nil, false
+}
+
+// requiredAzurermVersion returns the version constraints of azurerm in the required_providers blocks, or nil
+func requiredAzurermVersion(runner tflint.Runner) (version.Constraints, error) {
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "terraform",
+				Body: &hclext.BodySchema{
+					Blocks: []hclext.BlockSchema{
+						{
+							Type: "required_providers",
+							Body: &hclext.BodySchema{Mode: hclext.SchemaJustAttributesMode},
+						},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var constraints version.Constraints
+	for _, terraform := range content.Blocks {
+		for _, providers := range terraform.Body.Blocks {
+			for name, attribute := range providers.Body.Attributes {
+				value, diags := attribute.Expr.Value(nil)
+				if diags.HasErrors() || value.IsNull() || !value.Type().IsObjectType() {
+					continue
+				}
+
+				source := name
+				if value.Type().HasAttribute("source") && value.GetAttr("source").Type() == cty.String {
+					source = value.GetAttr("source").AsString()
+				}
+				if source != "azurerm" && !isAzurermSource(source) {
+					continue
+				}
+				if !value.Type().HasAttribute("version") || value.GetAttr("version").Type() != cty.String {
+					continue
+				}
+				required, err := version.NewConstraint(value.GetAttr("version").AsString())
+				if err != nil {
+					continue
+				}
+				constraints = append(constraints, required...)
+			}
+		}
+	}
+	return constraints, nil
+}
+
+// newestAllowedVersion returns the newest version allowed by the constraints, as terraform init would select it.
+// Candidates are the versions named in the constraints and the first and last versions of every released major version.
+func newestAllowedVersion(constraints version.Constraints) *version.Version {
+	candidates := []*version.Version{}
+	for _, constraint := range constraints {
+		if candidate, err := version.NewVersion(strings.TrimSpace(strings.TrimLeft(constraint.String(), "=!<>~ "))); err == nil {
+			candidates = append(candidates, candidate)
+		}
+	}
+	for major := 1; major <= defaultAzurermMajorVersion; major++ {
+		candidates = append(candidates, version.Must(version.NewVersion(fmt.Sprintf("%d.0.0", major))), version.Must(version.NewVersion(fmt.Sprintf("%d.999.999", major))))
+	}
+
+	var newest *version.Version
+	for _, candidate := range candidates {
+		if constraints.Check(candidate) && (newest == nil || candidate.GreaterThan(newest)) {
+			newest = candidate
+		}
+	}
+	return newest
+}
+
+// isAzurermSource returns whether the provider source is azurerm, with or without the registry host
+func isAzurermSource(source string) bool {
+	source = strings.TrimPrefix(strings.ToLower(source), "registry.terraform.io/")
+	return source == azurermProviderSource
+}
@@ -0,0 +1,209 @@
+package rules
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AzurermMajorVersion(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Files    map[string]string
+		Expected int
+	}{
+		{
+			Name:     "no constraint",
+			Files:    map[string]string{"main.tf": ``},
+			Expected: 4,
+		},
+		{
+			Name: "pessimistic v3 constraint",
+			Files: map[string]string{"main.tf": `
+terraform {
+  required_providers {
+    azurerm = {
+      source  = "hashicorp/azurerm"
+      version = "~> 3.100"
+    }
+  }
+}`},
+			Expected: 3,
+		},
+		{
+			Name: "upper bound below v4",
+			Files: map[string]string{"main.tf": `
+terraform {
+  required_providers {
+    azurerm = {
+      source  = "registry.terraform.io/hashicorp/azurerm"
+      version = ">= 3.0, < 4.0"
+    }
+  }
+}`},
+			Expected: 3,
+		},
+		{
+			Name: "exact v3 version under a local name",
+			Files: map[string]string{"main.tf": `
+terraform {
+  required_providers {
+    az = {
+      source  = "hashicorp/azurerm"
+      version = "3.116.0"
+    }
+  }
+}`},
+			Expected: 3,
+		},
+		{
+			Name: "lower bound only",
+			Files: map[string]string{"main.tf": `
+terraform {
+  required_providers {
+    azurerm = {
+      source  = "hashicorp/azurerm"
+      version = ">= 3.0"
+    }
+  }
+}`},
+			Expected: 4,
+		},
+		{
+			Name: "other provider",
+			Files: map[string]string{"main.tf": `
+terraform {
+  required_providers {
+    azapi = {
+      source  = "azure/azapi"
+      version = "~> 1.0"
+    }
+  }
+}`},
+			Expected: 4,
+		},
+		{
+			Name: "lock file takes precedence",
+			Files: map[string]string{
+				"main.tf": `
+terraform {
+  required_providers {
+    azurerm = {
+      source  = "hashicorp/azurerm"
+      version = ">= 3.0"
+    }
+  }
+}`,
+				".terraform.lock.hcl": `
+provider "registry.terraform.io/hashicorp/azurerm" {
+  version     = "3.116.0"
+  constraints = ">= 3.0.0"
+  hashes = [
+    "h1:BDHSr/QGjtAynM8mK1hz/s8A0CHaZBqnDEBDr3bWSL0=",
+  ]
+}`,
+			},
+			Expected: 3,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, test.Files)
+
+			major, err := azurermMajorVersion(runner)
+			if err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+			if major != test.Expected {
+				t.Errorf("Expected azurerm v%d, got v%d", test.Expected, major)
+			}
+		})
+	}
+}
+
+func Test_AzurermMajorVersionLockFileOfModuleDirectory(t *testing.T) {
+	lockFile := `
+provider "registry.terraform.io/hashicorp/azurerm" {
+  version = "3.116.0"
+}`
+	dir := t.TempDir()
+	if err := os.Mkdir(filepath.Join(dir, "app"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "app", lockFileName), []byte(lockFile), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(filepath.Join(dir, "other"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	// File names are relative to the original working directory, as with --chdir and --recursive
+	t.Chdir(dir)
+
+	tests := []struct {
+		Name     string
+		Files    map[string]string
+		Expected int
+	}{
+		{
+			Name:     "lock file of the module directory",
+			Files:    map[string]string{"app/main.tf": ``},
+			Expected: 3,
+		},
+		{
+			Name:     "lock file of the module directory given as an absolute path",
+			Files:    map[string]string{filepath.Join(dir, "app", "main.tf"): ``},
+			Expected: 3,
+		},
+		{
+			Name:     "module directory without a lock file",
+			Files:    map[string]string{"other/main.tf": ``},
+			Expected: 4,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, test.Files)
+
+			major, err := azurermMajorVersion(runner)
+			if err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+			if major != test.Expected {
+				t.Errorf("Expected azurerm v%d, got v%d", test.Expected, major)
+			}
+		})
+	}
+}
+
+func Test_VersionedAttributeFix(t *testing.T) {
+	rule := NewAzurermStorageAccountHTTPSTrafficOnlyEnabled()
+	runner := helper.TestRunner(t, map[string]string{"resource.tf": `
+resource "azurerm_storage_account" "example" {
+    enable_https_traffic_only = true
+}`})
+
+	if err := rule.Check(runner); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	helper.AssertIssues(t, helper.Issues{
+		{
+			Rule:    rule,
+			Message: "enable_https_traffic_only is the azurerm v3 name of https_traffic_only_enabled, the configuration uses azurerm v4",
+			Range: hcl.Range{
+				Filename: "resource.tf",
+				Start:    hcl.Pos{Line: 3, Column: 5},
+				End:      hcl.Pos{Line: 3, Column: 30},
+			},
+		},
+	}, runner.Issues)
+	helper.AssertChanges(t, map[string]string{"resource.tf": `
+resource "azurerm_storage_account" "example" {
+  https_traffic_only_enabled = true
+}`}, runner.Changes())
+}
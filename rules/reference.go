@@ -0,0 +1,273 @@
+package rules
+
+import (
+	"slices"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// referenceResolver finds the resources referenced by expressions, following local values.
+// Function calls such as one() and try(), splats and for expressions are looked through,
+// since the references of an expression include those of its arguments and collections.
+type referenceResolver struct {
+	locals map[string]hcl.Expression
+}
+
+// newReferenceResolver returns a resolver for the local values of the module
+func newReferenceResolver(runner tflint.Runner) (*referenceResolver, error) {
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "locals",
+				Body: &hclext.BodySchema{Mode: hclext.SchemaJustAttributesMode},
+			},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return nil, err
+	}
+
+	resolver := &referenceResolver{locals: map[string]hcl.Expression{}}
+	for _, locals := range content.Blocks {
+		for name, attribute := range locals.Body.Attributes {
+			resolver.locals[name] = attribute.Expr
+		}
+	}
+	return resolver, nil
+}
+
+// ReferencedResources returns the names of the resources of the given type referenced by the expression,
+// either through their id, such as azurerm_storage_account.example[each.key].id, or as a whole,
+// such as in a splat or a for_each over the resource
+func (r *referenceResolver) ReferencedResources(expr hcl.Expression, resourceType string) []string {
+	return r.ReferencedResourcesBy(expr, resourceType, "id")
+}
+
+// ReferencedResourcesBy returns the names of the resources of the given type referenced by the expression,
+// through the given attribute instead of their id, such as the name of a parent resource
+func (r *referenceResolver) ReferencedResourcesBy(expr hcl.Expression, resourceType string, attribute string) []string {
+	return r.referencedResources(expr, resourceType, attribute, map[string]bool{})
+}
+
+func (r *referenceResolver) referencedResources(expr hcl.Expression, resourceType string, attribute string, visited map[string]bool) []string {
+	var names []string
+	for _, traversal := range expr.Variables() {
+		switch traversal.RootName() {
+		case resourceType:
+			if name, ok := resourceReferenceName(traversal, attribute); ok {
+				names = append(names, name)
+			}
+		case "local":
+			if len(traversal) < 2 {
+				continue
+			}
+			local, ok := traversal[1].(hcl.TraverseAttr)
+			if !ok || visited[local.Name] {
+				continue
+			}
+			visited[local.Name] = true
+			if value, exists := r.locals[local.Name]; exists {
+				names = append(names, r.referencedResources(value, resourceType, attribute, visited)...)
+			}
+		}
+	}
+	return names
+}
+
+// resourceReferenceName returns the resource name of a traversal to a whole resource or to the given attribute of it
+func resourceReferenceName(traversal hcl.Traversal, attribute string) (string, bool) {
+	if len(traversal) < 2 {
+		return "", false
+	}
+	name, ok := traversal[1].(hcl.TraverseAttr)
+	if !ok {
+		return "", false
+	}
+
+	rest := traversal[2:]
+	if len(rest) > 0 {
+		if _, ok := rest[0].(hcl.TraverseIndex); ok {
+			rest = rest[1:]
+		}
+	}
+	if len(rest) == 0 {
+		return name.Name, true
+	}
+	if attr, ok := rest[0].(hcl.TraverseAttr); ok && len(rest) == 1 && attr.Name == attribute {
+		return name.Name, true
+	}
+	return "", false
+}
+
+// referencesEach returns whether the expression references each.key or each.value
+func referencesEach(expr hcl.Expression) bool {
+	for _, traversal := range expr.Variables() {
+		if traversal.RootName() != "each" || len(traversal) < 2 {
+			continue
+		}
+		if attr, ok := traversal[1].(hcl.TraverseAttr); ok && (attr.Name == "key" || attr.Name == "value") {
+			return true
+		}
+	}
+	return false
+}
+
+// referencesCountIndex returns whether the expression references count.index
+func referencesCountIndex(expr hcl.Expression) bool {
+	for _, traversal := range expr.Variables() {
+		if traversal.RootName() == "count" {
+			return true
+		}
+	}
+	return false
+}
+
+// association is a resource type that attaches itself to another resource through the ID of that resource,
+// such as azurerm_network_security_perimeter_association with its resource_id
+type association struct {
+	ResourceType string
+	// Path is the attribute holding the resource ID, preceded by the types of the nested blocks containing it
+	Path []string
+	// Attributes are the other attributes read from the block holding the resource ID
+	Attributes []string
+	// Blocks are the nested blocks read from the block holding the resource ID
+	Blocks []hclext.BlockSchema
+	// ReferenceAttribute is the attribute of the resource the association refers to, the id when empty.
+	// Some child resources, such as azurerm_mysql_flexible_server_configuration, identify their parent by name.
+	ReferenceAttribute string
+}
+
+// associationIndex maps the names of the associated resources to the blocks holding their ID
+type associationIndex map[string][]*hclext.Block
+
+// newAssociationIndex returns the resources of the given type referenced by the associations of the module.
+// Associations are not expanded so that those created with count or for_each are matched as a whole:
+// an ID built from each.key or each.value refers to the resources the for_each iterates over.
+func newAssociationIndex(runner tflint.Runner, assoc association, resourceType string) (associationIndex, error) {
+	resolver, err := newReferenceResolver(runner)
+	if err != nil {
+		return nil, err
+	}
+
+	referenceAttribute := assoc.ReferenceAttribute
+	if referenceAttribute == "" {
+		referenceAttribute = "id"
+	}
+
+	attributeName := assoc.Path[len(assoc.Path)-1]
+	attributes := []hclext.AttributeSchema{{Name: attributeName}}
+	for _, name := range assoc.Attributes {
+		attributes = append(attributes, hclext.AttributeSchema{Name: name})
+	}
+	schema := &hclext.BodySchema{Attributes: attributes, Blocks: assoc.Blocks}
+	blockTypes := assoc.Path[:len(assoc.Path)-1]
+	for i := len(blockTypes) - 1; i >= 0; i-- {
+		schema = &hclext.BodySchema{Blocks: []hclext.BlockSchema{{Type: blockTypes[i], Body: schema}}}
+	}
+	schema.Attributes = append(schema.Attributes, hclext.AttributeSchema{Name: "for_each"})
+
+	resources, err := runner.GetResourceContent(assoc.ResourceType, schema, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return nil, err
+	}
+
+	index := associationIndex{}
+	for _, resource := range resources.Blocks {
+		blocks := []*hclext.Block{resource}
+		for _, blockType := range blockTypes {
+			var nested []*hclext.Block
+			for _, block := range blocks {
+				nested = append(nested, block.Body.Blocks.OfType(blockType)...)
+			}
+			blocks = nested
+		}
+
+		for _, block := range blocks {
+			resourceID, exists := block.Body.Attributes[attributeName]
+			if !exists {
+				continue
+			}
+
+			names := resolver.ReferencedResourcesBy(resourceID.Expr, resourceType, referenceAttribute)
+			// for_each = azurerm_storage_account.example with resource_id = each.value.id
+			if forEach, exists := resource.Body.Attributes["for_each"]; exists && referencesEach(resourceID.Expr) {
+				names = append(names, resolver.ReferencedResourcesBy(forEach.Expr, resourceType, referenceAttribute)...)
+			}
+			for _, name := range names {
+				if !slices.Contains(index[name], block) {
+					index[name] = append(index[name], block)
+				}
+			}
+		}
+	}
+	return index, nil
+}
+
+// evaluateUnmarked evaluates the expression and calls the callback with its value.
+// Sensitive values are passed marked by the runner, they are unmarked before the callback reads their content.
+func evaluateUnmarked(runner tflint.Runner, expr hcl.Expression, callback func(val cty.Value) error) error {
+	return runner.EvaluateExpr(expr, func(val cty.Value) error {
+		val, _ = val.UnmarkDeep()
+		return callback(val)
+	}, nil)
+}
+
+// evaluateStrings evaluates a string or a collection of strings of an association block.
+// Associations are not expanded, so known is false for values taken from each or count,
+// as well as for values that cannot be evaluated such as unknown variables.
+func evaluateStrings(runner tflint.Runner, expr hcl.Expression) (values []string, known bool, err error) {
+	if referencesEach(expr) || referencesCountIndex(expr) {
+		return nil, false, nil
+	}
+	return evaluateStringList(runner, expr)
+}
+
+// evaluateValue evaluates an expression of an association block.
+// known is false for values taken from each or count, as well as for unknown or null values.
+func evaluateValue(runner tflint.Runner, expr hcl.Expression) (value cty.Value, known bool, err error) {
+	if referencesEach(expr) || referencesCountIndex(expr) {
+		return cty.NilVal, false, nil
+	}
+	err = evaluateUnmarked(runner, expr, func(val cty.Value) error {
+		if val.IsWhollyKnown() && !val.IsNull() {
+			value, known = val, true
+		}
+		return nil
+	})
+	if err != nil {
+		return cty.NilVal, false, err
+	}
+	return value, known, nil
+}
+
+// evaluateStringList evaluates a string or a collection of strings.
+// known is false for values that cannot be evaluated, such as unknown variables.
+func evaluateStringList(runner tflint.Runner, expr hcl.Expression) (values []string, known bool, err error) {
+	err = evaluateUnmarked(runner, expr, func(val cty.Value) error {
+		if !val.IsWhollyKnown() || val.IsNull() {
+			return nil
+		}
+		if val.Type() == cty.String {
+			values, known = []string{val.AsString()}, true
+			return nil
+		}
+		if !val.CanIterateElements() {
+			return nil
+		}
+		known = true
+		for it := val.ElementIterator(); it.Next(); {
+			_, element := it.Element()
+			if element.Type() == cty.String && !element.IsNull() {
+				values = append(values, element.AsString())
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return values, known, nil
+}
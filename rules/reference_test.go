@@ -0,0 +1,162 @@
+package rules
+
+import (
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_AssociationIndex(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected []string
+	}{
+		{
+			Name: "id",
+			Content: `
+resource "azurerm_network_security_perimeter_association" "example" {
+  resource_id = azurerm_storage_account.example.id
+}`,
+			Expected: []string{"example"},
+		},
+		{
+			Name: "other attribute",
+			Content: `
+resource "azurerm_network_security_perimeter_association" "example" {
+  resource_id = azurerm_storage_account.example.primary_blob_endpoint
+}`,
+			Expected: []string{},
+		},
+		{
+			Name: "other resource type",
+			Content: `
+resource "azurerm_network_security_perimeter_association" "example" {
+  resource_id = azurerm_key_vault.example.id
+}`,
+			Expected: []string{},
+		},
+		{
+			Name: "one of a splat",
+			Content: `
+resource "azurerm_network_security_perimeter_association" "example" {
+  resource_id = one(azurerm_storage_account.example[*].id)
+}`,
+			Expected: []string{"example"},
+		},
+		{
+			Name: "try with a fallback",
+			Content: `
+resource "azurerm_network_security_perimeter_association" "example" {
+  resource_id = try(azurerm_storage_account.primary[0].id, azurerm_storage_account.secondary[0].id)
+}`,
+			Expected: []string{"primary", "secondary"},
+		},
+		{
+			Name: "local value",
+			Content: `
+locals {
+  storage_account_id = azurerm_storage_account.example.id
+}
+
+resource "azurerm_network_security_perimeter_association" "example" {
+  resource_id = local.storage_account_id
+}`,
+			Expected: []string{"example"},
+		},
+		{
+			Name: "local value referencing another local value",
+			Content: `
+locals {
+  storage_accounts    = azurerm_storage_account.example
+  storage_account_ids = { for key, account in local.storage_accounts : key => account.id }
+}
+
+resource "azurerm_network_security_perimeter_association" "example" {
+  for_each    = local.storage_account_ids
+  resource_id = each.value
+}`,
+			Expected: []string{"example"},
+		},
+		{
+			Name: "local values referencing each other",
+			Content: `
+locals {
+  a = local.b
+  b = local.a
+}
+
+resource "azurerm_network_security_perimeter_association" "example" {
+  resource_id = local.a
+}`,
+			Expected: []string{},
+		},
+		{
+			Name: "for_each keys",
+			Content: `
+resource "azurerm_network_security_perimeter_association" "example" {
+  for_each    = { for account in azurerm_storage_account.example : account.id => account.name }
+  resource_id = each.key
+}`,
+			Expected: []string{"example"},
+		},
+		{
+			Name: "for_each over another resource",
+			Content: `
+resource "azurerm_network_security_perimeter_association" "example" {
+  for_each    = azurerm_key_vault.example
+  resource_id = each.value.id
+}`,
+			Expected: []string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			index, err := newAssociationIndex(runner, networkSecurityPerimeterAssociation, "azurerm_storage_account")
+			if err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			names := []string{}
+			for name := range index {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			if !slices.Equal(names, test.Expected) {
+				t.Errorf("Expected associated resources %v, got %v", test.Expected, names)
+			}
+		})
+	}
+}
+
+func Test_AssociationIndexNestedPath(t *testing.T) {
+	runner := helper.TestRunner(t, map[string]string{"resource.tf": `
+resource "azurerm_private_endpoint" "example" {
+  private_service_connection {
+    private_connection_resource_id = azurerm_storage_account.example.id
+    subresource_names              = ["blob"]
+  }
+}`})
+
+	index, err := newAssociationIndex(runner, association{
+		ResourceType: "azurerm_private_endpoint",
+		Path:         []string{"private_service_connection", "private_connection_resource_id"},
+		Attributes:   []string{"subresource_names"},
+	}, "azurerm_storage_account")
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	blocks := index["example"]
+	if len(blocks) != 1 {
+		t.Fatalf("Expected 1 private_service_connection block, got %d", len(blocks))
+	}
+	if _, exists := blocks[0].Body.Attributes["subresource_names"]; !exists {
+		t.Error("Expected subresource_names to be read from the private_service_connection block")
+	}
+}
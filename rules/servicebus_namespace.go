@@ -0,0 +1,53 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// servicebusNamespaceResourceTypes are the resource types of the Service Bus namespace rules
+var servicebusNamespaceResourceTypes = []string{"azurerm_servicebus_namespace"}
+
+// servicebusAuthorizationRuleResourceTypes are the shared access policies of Service Bus namespaces, queues and topics
+var servicebusAuthorizationRuleResourceTypes = []string{
+	"azurerm_servicebus_namespace_authorization_rule",
+	"azurerm_servicebus_queue_authorization_rule",
+	"azurerm_servicebus_topic_authorization_rule",
+}
+
+// premiumServicebusNamespace restricts rules to the Premium SKU, the only one supporting customer-managed keys
+var premiumServicebusNamespace = &AttributeRuleCondition{Attribute: "sku", Values: []string{"Premium"}}
+
+// servicebusNamespaceRuleSpecs declares the attribute rules of the Service Bus namespaces and their authorization rules
+var servicebusNamespaceRuleSpecs = []*AttributeRuleSpec{
+	{
+		Name:          "minimum_tls_version",
+		ResourceTypes: servicebusNamespaceResourceTypes,
+		AttributePath: []string{"minimum_tls_version"},
+		Type:          cty.String,
+		Expected:      tlsVersionsAtLeast(DefaultMinimumTLSVersion, servicebusTLSVersions),
+		Enabled:       true,
+		Severity:      tflint.WARNING,
+		Messages: AttributeRuleMessages{
+			InvalidValue: "minimum_tls_version is set to {value}, should be {expected}",
+		},
+		// minimum_tls_version defaults to 1.2
+		OptionalAttribute: true,
+		TLSVersions:       servicebusTLSVersions,
+		DecodeConfig:      decodeTLSVersionRuleConfig,
+	},
+	{
+		Name:          "local_auth_enabled",
+		ResourceTypes: servicebusNamespaceResourceTypes,
+		AttributePath: []string{"local_auth_enabled"},
+		Type:          cty.Bool,
+		Expected:      []string{"false"},
+		Enabled:       true,
+		Severity:      tflint.WARNING,
+		Messages: AttributeRuleMessages{
+			MissingAttribute: "local_auth_enabled is not defined and defaults to true, should be false",
+			InvalidValue:     "local_auth_enabled should be false, shared access keys bypass Microsoft Entra authorization",
+		},
+	},
+	newAuthorizationRuleManageSpec(servicebusAuthorizationRuleResourceTypes),
+}
@@ -0,0 +1,252 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+)
+
+// storageAccountResourceTypes are the resource types of the Storage Account rules
+var storageAccountResourceTypes = []string{"azurerm_storage_account"}
+
+// storageAccountKeysDisabled holds for Storage Accounts that do not accept their access keys
+var storageAccountKeysDisabled = &AttributeRuleCondition{Attribute: "shared_access_key_enabled", Values: []string{"false"}}
+
+// storageAccountWithoutBlobProperties holds for Storage Accounts of a kind that does not support blob_properties
+var storageAccountWithoutBlobProperties = &AttributeRuleCondition{Attribute: "account_kind", Values: []string{"FileStorage"}}
+
+// storageAccountHierarchicalNamespace holds for Data Lake Storage accounts, which do not support blob versioning
+var storageAccountHierarchicalNamespace = &AttributeRuleCondition{Attribute: "is_hns_enabled", Values: []string{"true"}}
+
+// storageAccountRuleSpecs declares the attribute rules of the Storage Accounts and their containers
+var storageAccountRuleSpecs = []*AttributeRuleSpec{
+	{
+		Name:          "shared_access_key_enabled",
+		ResourceTypes: storageAccountResourceTypes,
+		AttributePath: []string{"shared_access_key_enabled"},
+		Type:          cty.Bool,
+		Expected:      []string{"false"},
+		Enabled:       true,
+		Severity:      tflint.WARNING,
+		Messages: AttributeRuleMessages{
+			MissingAttribute: "shared_access_key_enabled is not defined and defaults to true, should be false",
+			InvalidValue:     "shared_access_key_enabled should be false, account keys grant full access to the data and bypass Microsoft Entra authorization",
+		},
+	},
+	{
+		Name:          "allow_nested_items_to_be_public",
+		ResourceTypes: storageAccountResourceTypes,
+		AttributePath: []string{"allow_nested_items_to_be_public"},
+		Type:          cty.Bool,
+		Expected:      []string{"false"},
+		Enabled:       true,
+		Severity:      tflint.WARNING,
+		Messages: AttributeRuleMessages{
+			MissingAttribute: "allow_nested_items_to_be_public is not defined and defaults to true, should be false",
+			InvalidValue:     "allow_nested_items_to_be_public should be false, containers could allow anonymous read access",
+		},
+	},
+	{
+		Name:          "infrastructure_encryption_enabled",
+		ResourceTypes: storageAccountResourceTypes,
+		AttributePath: []string{"infrastructure_encryption_enabled"},
+		Type:          cty.Bool,
+		Expected:      []string{"true"},
+		// Infrastructure encryption can only be enabled when the account is created
+		Enabled:  false,
+		Severity: tflint.NOTICE,
+		Messages: AttributeRuleMessages{
+			MissingAttribute: "infrastructure_encryption_enabled is not defined and should be true to encrypt the data twice",
+			InvalidValue:     "infrastructure_encryption_enabled should be true to encrypt the data twice",
+		},
+		// Changing infrastructure_encryption_enabled recreates the account
+		NoFix: true,
+	},
+	{
+		Name:          "versioning_enabled",
+		ResourceTypes: storageAccountResourceTypes,
+		AttributePath: []string{"blob_properties", "versioning_enabled"},
+		Type:          cty.Bool,
+		Expected:      []string{"true"},
+		Enabled:       true,
+		Severity:      tflint.NOTICE,
+		Messages: AttributeRuleMessages{
+			MissingBlock:     "blob_properties block is missing, versioning_enabled should be true to keep previous versions of blobs",
+			MissingAttribute: "versioning_enabled is missing in blob_properties, should be true to keep previous versions of blobs",
+			InvalidValue:     "versioning_enabled should be true to keep previous versions of blobs",
+		},
+		Unless: []*AttributeRuleCondition{storageAccountWithoutBlobProperties, storageAccountHierarchicalNamespace},
+	},
+	{
+		Name:          "container_access_type",
+		ResourceTypes: []string{"azurerm_storage_container"},
+		AttributePath: []string{"container_access_type"},
+		Type:          cty.String,
+		Expected:      []string{"private"},
+		Enabled:       true,
+		Severity:      tflint.WARNING,
+		Messages: AttributeRuleMessages{
+			InvalidValue: "container_access_type is {value}, blobs can be read anonymously, should be private",
+		},
+		// container_access_type defaults to private
+		OptionalAttribute: true,
+	},
+}
+
+// storageAccountDeleteRetentionPolicySpec declares a soft delete policy of the blob_properties block
+type storageAccountDeleteRetentionPolicySpec struct {
+	// Name is the rule name without the resource type prefix
+	Name string
+	// BlockType is the block of blob_properties enabling the soft delete
+	BlockType string
+	// Title is the name of the deleted items used in issue messages
+	Title string
+}
+
+// storageAccountDeleteRetentionPolicySpecs are the soft delete policies checked by the Storage Account retention rules
+var storageAccountDeleteRetentionPolicySpecs = []storageAccountDeleteRetentionPolicySpec{
+	{Name: "blob_delete_retention_policy", BlockType: "delete_retention_policy", Title: "deleted blobs"},
+	{Name: "container_delete_retention_policy", BlockType: "container_delete_retention_policy", Title: "deleted containers"},
+}
+
+// StorageAccountDeleteRetentionPolicy checks that Storage Accounts soft delete blobs or containers for a minimum retention
+type StorageAccountDeleteRetentionPolicy struct {
+	tflint.DefaultRule
+
+	resourceType string
+	spec         storageAccountDeleteRetentionPolicySpec
+}
+
+// NewStorageAccountDeleteRetentionPolicyRules returns a rule instance for every soft delete policy of Storage Accounts
+func NewStorageAccountDeleteRetentionPolicyRules() []tflint.Rule {
+	var rules []tflint.Rule
+	for _, spec := range storageAccountDeleteRetentionPolicySpecs {
+		rules = append(rules, &StorageAccountDeleteRetentionPolicy{
+			resourceType: "azurerm_storage_account",
+			spec:         spec,
+		})
+	}
+	return rules
+}
+
+// Name returns the rule name
+func (r *StorageAccountDeleteRetentionPolicy) Name() string {
+	return r.resourceType + "_" + r.spec.Name
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *StorageAccountDeleteRetentionPolicy) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *StorageAccountDeleteRetentionPolicy) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *StorageAccountDeleteRetentionPolicy) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Check checks that blob_properties sets the soft delete policy, keeping deleted items for at least the configured minimum.
+// FileStorage accounts, which do not support blob_properties, are skipped.
+func (r *StorageAccountDeleteRetentionPolicy) Check(runner tflint.Runner) error {
+	minimum, err := decodeRetentionRuleConfig(runner, r.Name(), DefaultSoftDeleteRetentionDays)
+	if err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: storageAccountWithoutBlobProperties.Attribute}},
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "blob_properties",
+				Body: &hclext.BodySchema{
+					Blocks: []hclext.BlockSchema{
+						{
+							Type: r.spec.BlockType,
+							Body: &hclext.BodySchema{
+								Attributes: []hclext.AttributeSchema{{Name: "days"}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		withoutBlobProperties, err := storageAccountWithoutBlobProperties.Holds(runner, resource)
+		if err != nil {
+			return err
+		}
+		if withoutBlobProperties {
+			continue
+		}
+
+		properties := resource.Body.Blocks.OfType("blob_properties")
+		if len(properties) == 0 {
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("blob_properties block is missing, %s should keep %s for at least %d days", r.spec.BlockType, r.spec.Title, minimum),
+				resource.DefRange,
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		policies := properties[0].Body.Blocks.OfType(r.spec.BlockType)
+		if len(policies) == 0 {
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("%s is missing in blob_properties, %s should be kept for at least %d days", r.spec.BlockType, r.spec.Title, minimum),
+				properties[0].DefRange,
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		attribute, exists := policies[0].Body.Attributes["days"]
+		if !exists {
+			if DefaultSoftDeleteRetentionDays >= minimum {
+				continue
+			}
+			if err := runner.EmitIssueWithFix(
+				r,
+				fmt.Sprintf("days is not defined in %s and defaults to %d, %s should be kept for at least %d days", r.spec.BlockType, DefaultSoftDeleteRetentionDays, r.spec.Title, minimum),
+				policies[0].DefRange,
+				fixInsertAttribute(runner, policies[0], "days", cty.NumberIntVal(int64(minimum))),
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		err = runner.EvaluateExpr(attribute.Expr, func(days int) error {
+			if days >= minimum {
+				return nil
+			}
+			return runner.EmitIssueWithFix(
+				r,
+				fmt.Sprintf("days is %d in %s, %s should be kept for at least %d days", days, r.spec.BlockType, r.spec.Title, minimum),
+				attribute.Expr.Range(),
+				fixReplaceLiteral(attribute, cty.NumberIntVal(int64(minimum))),
+			)
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
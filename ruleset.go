@@ -0,0 +1,114 @@
+// ruleset.go
+package main
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/rules"
+)
+
+// RuleSet is the azurerm-security ruleset. It extends the BuiltinRuleSet with
+// the settings declared in the `plugin "azurerm-security"` block.
+type RuleSet struct {
+	tflint.BuiltinRuleSet
+
+	config       *rules.Config
+	globalConfig *tflint.Config
+}
+
+// ConfigSchema returns the schema of the plugin block
+func (r *RuleSet) ConfigSchema() *hclext.BodySchema {
+	return hclext.ImpliedBodySchema(&rules.Config{})
+}
+
+// ApplyGlobalConfig enables the rules according to the TFLint config.
+// The config is kept to enable the rules again once the profile is known, see enableRules.
+func (r *RuleSet) ApplyGlobalConfig(config *tflint.Config) error {
+	r.globalConfig = config
+	return r.BuiltinRuleSet.ApplyGlobalConfig(config)
+}
+
+// ApplyConfig decodes the plugin block and passes the settings to every rule
+func (r *RuleSet) ApplyConfig(body *hclext.BodyContent) error {
+	config := &rules.Config{}
+	if body != nil {
+		if diags := hclext.DecodeBody(body, nil, config); diags.HasErrors() {
+			return diags
+		}
+	}
+	config.SetDefaults()
+	if err := config.Validate(); err != nil {
+		return err
+	}
+	r.config = config
+
+	for _, rule := range r.Rules {
+		if configurable, ok := rule.(rules.ConfigurableRule); ok {
+			configurable.ApplyConfig(config)
+		}
+	}
+
+	if r.globalConfig != nil {
+		return r.enableRules(r.profile())
+	}
+	return nil
+}
+
+// enableRules enables the rules with BuiltinRuleSet.ApplyGlobalConfig,
+// with the rules not configured in .tflint.hcl following the profile
+func (r *RuleSet) enableRules(profile *rules.Profile) error {
+	wrapped := &tflint.BuiltinRuleSet{}
+	for _, rule := range r.Rules {
+		wrapped.Rules = append(wrapped.Rules, &profileEnabledRule{Rule: rule, profile: profile})
+	}
+	if err := wrapped.ApplyGlobalConfig(r.globalConfig); err != nil {
+		return err
+	}
+
+	r.EnabledRules = []tflint.Rule{}
+	for _, rule := range wrapped.EnabledRules {
+		r.EnabledRules = append(r.EnabledRules, rule.(*profileEnabledRule).Rule)
+	}
+
+	for _, rule := range r.Rules {
+		if aware, ok := rule.(rules.EnabledRulesAwareRule); ok {
+			aware.ApplyEnabledRules(r.EnabledRules)
+		}
+	}
+	return nil
+}
+
+// profileEnabledRule overrides whether a rule is enabled by default
+type profileEnabledRule struct {
+	tflint.Rule
+
+	profile *rules.Profile
+}
+
+// Enabled returns whether the rule is enabled by default under the profile
+func (r *profileEnabledRule) Enabled() bool {
+	return r.profile.RuleEnabled(r.Rule)
+}
+
+// profile returns the selected security profile
+func (r *RuleSet) profile() *rules.Profile {
+	name := rules.DefaultProfile
+	if r.config != nil {
+		name = r.config.Profile
+	}
+	profile, _ := rules.LookupProfile(name)
+	return profile
+}
+
+// NewRunner wraps the runner so that excluded resources are hidden from every rule
+// and issues are reported with the severity of the profile and the compliance controls of the rule
+func (r *RuleSet) NewRunner(runner tflint.Runner) (tflint.Runner, error) {
+	if r.config == nil {
+		return runner, nil
+	}
+	if len(r.config.Excludes) > 0 {
+		runner = &excludingRunner{Runner: runner, config: r.config}
+	}
+	runner = &complianceRunner{Runner: runner}
+	return &profileRunner{Runner: runner, profile: r.profile()}, nil
+}
@@ -0,0 +1,448 @@
+// ruleset_test.go
+package main
+
+import (
+	"path"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/rules"
+)
+
+func applyPluginConfig(t *testing.T, ruleSet *RuleSet, src string) error {
+	t.Helper()
+
+	file, diags := hclparse.NewParser().ParseHCL([]byte(src), "plugin.hcl")
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+	body, diags := hclext.Content(file.Body, ruleSet.ConfigSchema())
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+	return ruleSet.ApplyConfig(body)
+}
+
+func findRule(t *testing.T, ruleSet *RuleSet, name string) tflint.Rule {
+	t.Helper()
+
+	for _, rule := range ruleSet.Rules {
+		if rule.Name() == name {
+			return rule
+		}
+	}
+	t.Fatalf("Rule %s not found", name)
+	return nil
+}
+
+func TestApplyConfig(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Config   string
+		Content  string
+		Rule     string
+		Expected []string
+	}{
+		{
+			Name:   "default minimum TLS version",
+			Config: ``,
+			Content: `
+resource "azurerm_linux_web_app" "example" {
+    site_config {
+        minimum_tls_version = "1.1"
+    }
+}`,
+			Rule:     "azurerm_linux_web_app_minimum_tls_version",
+			Expected: []string{"minimum_tls_version is set to 1.1, should be 1.2 or 1.3"},
+		},
+		{
+			Name:   "minimum TLS version raised to 1.3",
+			Config: `minimum_tls_version = "1.3"`,
+			Content: `
+resource "azurerm_linux_web_app" "example" {
+    site_config {
+        minimum_tls_version = "1.2"
+    }
+}`,
+			Rule:     "azurerm_linux_web_app_minimum_tls_version",
+			Expected: []string{"minimum_tls_version is set to 1.2, should be 1.3"},
+		},
+		{
+			Name:   "minimum TLS version mapped to resource enum",
+			Config: `minimum_tls_version = "1.3"`,
+			Content: `
+resource "azurerm_storage_account" "example" {
+    min_tls_version = "TLS1_2"
+}`,
+			Rule:     "azurerm_storage_account_unsecure_tls",
+			Expected: []string{`"TLS1_2" is an insecure value as min_tls_version`},
+		},
+		{
+			Name:   "minimum TLS version capped to the newest supported version",
+			Config: `minimum_tls_version = "1.3"`,
+			Content: `
+resource "azurerm_redis_cache" "example" {
+    minimum_tls_version = "1.2"
+}`,
+			Rule:     "azurerm_redis_cache_minimum_tls_version",
+			Expected: []string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ruleSet := createRuleSet()
+			if err := applyPluginConfig(t, ruleSet, test.Config); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+			if err := findRule(t, ruleSet, test.Rule).Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			messages := []string{}
+			for _, issue := range runner.Issues {
+				messages = append(messages, issue.Message)
+			}
+			if len(messages) != len(test.Expected) {
+				t.Fatalf("Expected issues %v, got %v", test.Expected, messages)
+			}
+			for i := range messages {
+				if messages[i] != test.Expected[i] {
+					t.Errorf("Expected issue %q, got %q", test.Expected[i], messages[i])
+				}
+			}
+		})
+	}
+}
+
+func TestApplyConfigInvalid(t *testing.T) {
+	tests := []struct {
+		Name   string
+		Config string
+	}{
+		{
+			Name:   "unknown minimum TLS version",
+			Config: `minimum_tls_version = "1.4"`,
+		},
+		{
+			Name: "exclude label is not a resource type",
+			Config: `
+exclude "storage_account" {
+}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			if err := applyPluginConfig(t, createRuleSet(), test.Config); err == nil {
+				t.Fatal("Expected an error, got none")
+			}
+		})
+	}
+}
+
+func TestNewRunnerExcludes(t *testing.T) {
+	content := `
+resource "azurerm_storage_account" "legacy" {
+    https_traffic_only_enabled = false
+}
+
+resource "azurerm_storage_account" "example" {
+    https_traffic_only_enabled = false
+}
+
+resource "azurerm_redis_cache" "example" {
+    non_ssl_port_enabled = true
+}`
+
+	ruleSet := createRuleSet()
+	err := applyPluginConfig(t, ruleSet, `
+exclude "azurerm_storage_account" {
+    names = ["legacy"]
+}
+
+exclude "azurerm_redis_cache" {
+}`)
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	testRunner := helper.TestRunner(t, map[string]string{"resource.tf": content})
+	runner, err := ruleSet.NewRunner(testRunner)
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	for _, name := range []string{"azurerm_storage_account_https_traffic_only_enabled", "azurerm_redis_cache_non_ssl_port_enabled"} {
+		if err := findRule(t, ruleSet, name).Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+	}
+
+	if len(testRunner.Issues) != 1 {
+		t.Fatalf("Expected 1 issue, got %d", len(testRunner.Issues))
+	}
+	if testRunner.Issues[0].Range.Start.Line != 7 {
+		t.Errorf("Expected the issue on azurerm_storage_account.example, got line %d", testRunner.Issues[0].Range.Start.Line)
+	}
+}
+
+func TestNewRunnerCompliance(t *testing.T) {
+	ruleSet := createRuleSet()
+	if err := applyPluginConfig(t, ruleSet, ``); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	testRunner := helper.TestRunner(t, map[string]string{"resource.tf": `
+resource "azurerm_storage_account" "example" {
+    https_traffic_only_enabled = false
+}`})
+	runner, err := ruleSet.NewRunner(testRunner)
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if err := findRule(t, ruleSet, "azurerm_storage_account_https_traffic_only_enabled").Check(runner); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	if len(testRunner.Issues) != 1 {
+		t.Fatalf("Expected 1 issue, got %d", len(testRunner.Issues))
+	}
+	if suffix := " [CIS 3.1; MCSB DP-3; NIST SC-8, SC-8(1)]"; !strings.HasSuffix(testRunner.Issues[0].Message, suffix) {
+		t.Errorf("Expected the message to end with %q, got %q", suffix, testRunner.Issues[0].Message)
+	}
+}
+
+func TestProfiles(t *testing.T) {
+	ruleSet := createRuleSet()
+	ruleNames := map[string]bool{}
+	for _, rule := range ruleSet.Rules {
+		ruleNames[rule.Name()] = true
+	}
+
+	for _, profile := range rules.Profiles {
+		if profile.Extends != "" && profile.Parent() == nil {
+			t.Errorf("Profile %s extends unknown profile %s", profile.Name, profile.Extends)
+		}
+		if !slices.Contains([]string{"1.0", "1.1", "1.2", "1.3"}, profile.TLSVersion()) {
+			t.Errorf("Profile %s has an invalid minimum TLS version %s", profile.Name, profile.TLSVersion())
+		}
+		for _, pattern := range profile.Enable {
+			if _, err := path.Match(pattern, ""); err != nil {
+				t.Errorf("Profile %s has an invalid pattern %s: %s", profile.Name, pattern, err)
+			}
+			if !strings.ContainsAny(pattern, "*?[") && !ruleNames[pattern] {
+				t.Errorf("Profile %s enables unknown rule %s", profile.Name, pattern)
+			}
+		}
+	}
+
+	baseline, _ := rules.LookupProfile(rules.DefaultProfile)
+	strict, _ := rules.LookupProfile("strict")
+	for _, rule := range ruleSet.Rules {
+		// The baseline profile keeps the rule defaults
+		if baseline.RuleEnabled(rule) != rule.Enabled() || baseline.RuleSeverity(rule) != rule.Severity() {
+			t.Errorf("Profile baseline changes the defaults of rule %s", rule.Name())
+		}
+		// The strict profile turns on every rule, except the opt-in families of the regulated profile
+		if !strict.RuleEnabled(rule) && !optIn(rule.Name()) {
+			t.Errorf("Profile strict does not enable rule %s", rule.Name())
+		}
+		// Severities are ordered from ERROR to NOTICE
+		if rule.Severity() != tflint.ERROR && strict.RuleSeverity(rule) >= rule.Severity() {
+			t.Errorf("Profile strict does not raise the severity of rule %s", rule.Name())
+		}
+	}
+}
+
+// optIn returns whether the rule is only enabled by the regulated profile
+func optIn(name string) bool {
+	regulated, _ := rules.LookupProfile("regulated")
+	for _, pattern := range regulated.Enable {
+		if matched, _ := path.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
+
+func TestApplyConfigProfile(t *testing.T) {
+	tests := []struct {
+		Name       string
+		Config     string
+		Global     *tflint.Config
+		Content    string
+		Rule       string
+		Enabled    bool
+		Severities []tflint.Severity
+	}{
+		{
+			Name:   "baseline keeps the defaults",
+			Config: ``,
+			Global: &tflint.Config{Rules: map[string]*tflint.RuleConfig{}},
+			Content: `
+resource "azurerm_key_vault" "example" {
+    public_network_access_enabled = true
+}`,
+			Rule:       "azurerm_key_vault_public_network_access_enabled",
+			Enabled:    true,
+			Severities: []tflint.Severity{tflint.NOTICE},
+		},
+		{
+			Name:   "strict raises severities",
+			Config: `profile = "strict"`,
+			Global: &tflint.Config{Rules: map[string]*tflint.RuleConfig{}},
+			Content: `
+resource "azurerm_key_vault" "example" {
+    public_network_access_enabled = true
+}`,
+			Rule:       "azurerm_key_vault_public_network_access_enabled",
+			Enabled:    true,
+			Severities: []tflint.Severity{tflint.WARNING},
+		},
+		{
+			Name:   "strict turns on rules disabled by default",
+			Config: `profile = "strict"`,
+			Global: &tflint.Config{Rules: map[string]*tflint.RuleConfig{}},
+			Content: `
+resource "azurerm_key_vault" "example" {
+    enable_rbac_authorization = false
+}`,
+			Rule:       "azurerm_key_vault_enable_rbac_authorization",
+			Enabled:    true,
+			Severities: []tflint.Severity{tflint.ERROR},
+		},
+		{
+			Name:   "strict keeps the opt-in rules of regulated disabled",
+			Config: `profile = "strict"`,
+			Global: &tflint.Config{Rules: map[string]*tflint.RuleConfig{}},
+			Rule:   "azurerm_key_vault_private_endpoint",
+		},
+		{
+			Name:   "regulated turns on the opt-in rules",
+			Config: `profile = "regulated"`,
+			Global: &tflint.Config{Rules: map[string]*tflint.RuleConfig{}},
+			Content: `
+resource "azurerm_key_vault" "example" {
+}`,
+			Rule:       "azurerm_key_vault_private_endpoint",
+			Enabled:    true,
+			Severities: []tflint.Severity{tflint.ERROR},
+		},
+		{
+			Name:   "baseline skips the AVM inputs of rules disabled by default",
+			Config: ``,
+			Global: &tflint.Config{Rules: map[string]*tflint.RuleConfig{}},
+			Content: `
+module "vault" {
+    source                         = "Azure/avm-res-keyvault-vault/azurerm"
+    legacy_access_policies_enabled = true
+}`,
+			Rule:       "azurerm_key_vault_avm_module_inputs",
+			Enabled:    true,
+			Severities: []tflint.Severity{},
+		},
+		{
+			Name:   "strict checks the AVM inputs of rules disabled by default",
+			Config: `profile = "strict"`,
+			Global: &tflint.Config{Rules: map[string]*tflint.RuleConfig{}},
+			Content: `
+module "vault" {
+    source                         = "Azure/avm-res-keyvault-vault/azurerm"
+    legacy_access_policies_enabled = true
+}`,
+			Rule:       "azurerm_key_vault_avm_module_inputs",
+			Enabled:    true,
+			Severities: []tflint.Severity{tflint.ERROR},
+		},
+		{
+			Name:   "strict requires TLS 1.3",
+			Config: `profile = "strict"`,
+			Global: &tflint.Config{Rules: map[string]*tflint.RuleConfig{}},
+			Content: `
+resource "azurerm_linux_web_app" "example" {
+    site_config {
+        minimum_tls_version = "1.2"
+    }
+}`,
+			Rule:       "azurerm_linux_web_app_minimum_tls_version",
+			Enabled:    true,
+			Severities: []tflint.Severity{tflint.ERROR},
+		},
+		{
+			Name: "explicit minimum TLS version overrides the profile",
+			Config: `
+profile             = "strict"
+minimum_tls_version = "1.2"`,
+			Global: &tflint.Config{Rules: map[string]*tflint.RuleConfig{}},
+			Content: `
+resource "azurerm_linux_web_app" "example" {
+    site_config {
+        minimum_tls_version = "1.2"
+    }
+}`,
+			Rule:       "azurerm_linux_web_app_minimum_tls_version",
+			Enabled:    true,
+			Severities: []tflint.Severity{},
+		},
+		{
+			Name:   "rule block overrides the profile",
+			Config: `profile = "strict"`,
+			Global: &tflint.Config{Rules: map[string]*tflint.RuleConfig{
+				"azurerm_key_vault_enable_rbac_authorization": {Name: "azurerm_key_vault_enable_rbac_authorization", Enabled: false},
+			}},
+			Rule:    "azurerm_key_vault_enable_rbac_authorization",
+			Enabled: false,
+		},
+		{
+			Name:   "disabled_by_default overrides the profile",
+			Config: `profile = "strict"`,
+			Global: &tflint.Config{Rules: map[string]*tflint.RuleConfig{}, DisabledByDefault: true},
+			Rule:   "azurerm_key_vault_enable_rbac_authorization",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ruleSet := createRuleSet()
+			if err := ruleSet.ApplyGlobalConfig(test.Global); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+			if err := applyPluginConfig(t, ruleSet, test.Config); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			rule := findRule(t, ruleSet, test.Rule)
+			if enabled := slices.Contains(ruleSet.EnabledRules, rule); enabled != test.Enabled {
+				t.Fatalf("Expected rule %s enabled to be %t, got %t", test.Rule, test.Enabled, enabled)
+			}
+			if !test.Enabled {
+				return
+			}
+
+			testRunner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+			runner, err := ruleSet.NewRunner(testRunner)
+			if err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			severities := []tflint.Severity{}
+			for _, issue := range testRunner.Issues {
+				severities = append(severities, issue.Rule.Severity())
+			}
+			if !slices.Equal(severities, test.Severities) {
+				t.Errorf("Expected severities %v, got %v", test.Severities, severities)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+// runner.go
+package main
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/rules"
+)
+
+// excludingRunner drops the resources excluded in the plugin block from the content returned to rules
+type excludingRunner struct {
+	tflint.Runner
+
+	config *rules.Config
+}
+
+// GetResourceContent returns the resources of the given type that are not excluded
+func (r *excludingRunner) GetResourceContent(resourceName string, schema *hclext.BodySchema, option *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
+	content, err := r.Runner.GetResourceContent(resourceName, schema, option)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := hclext.Blocks{}
+	for _, block := range content.Blocks {
+		if len(block.Labels) > 1 && r.config.IsExcluded(resourceName, block.Labels[1]) {
+			continue
+		}
+		blocks = append(blocks, block)
+	}
+	content.Blocks = blocks
+
+	return content, nil
+}
+
+// GetModuleContent returns the module content without the excluded resource blocks
+func (r *excludingRunner) GetModuleContent(schema *hclext.BodySchema, option *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
+	content, err := r.Runner.GetModuleContent(schema, option)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := hclext.Blocks{}
+	for _, block := range content.Blocks {
+		if block.Type == "resource" && len(block.Labels) > 1 && r.config.IsExcluded(block.Labels[0], block.Labels[1]) {
+			continue
+		}
+		blocks = append(blocks, block)
+	}
+	content.Blocks = blocks
+
+	return content, nil
+}
+
+// profileRunner reports issues with the severity set by the security profile
+type profileRunner struct {
+	tflint.Runner
+
+	profile *rules.Profile
+}
+
+// EmitIssue reports an issue with the severity of the profile
+func (r *profileRunner) EmitIssue(rule tflint.Rule, message string, issueRange hcl.Range) error {
+	return r.Runner.EmitIssue(r.rule(rule), message, issueRange)
+}
+
+// EmitIssueWithFix reports an issue with the severity of the profile and applies its fix
+func (r *profileRunner) EmitIssueWithFix(rule tflint.Rule, message string, issueRange hcl.Range, fixFunc func(f tflint.Fixer) error) error {
+	return r.Runner.EmitIssueWithFix(r.rule(rule), message, issueRange, fixFunc)
+}
+
+func (r *profileRunner) rule(rule tflint.Rule) tflint.Rule {
+	severity := r.profile.RuleSeverity(rule)
+	if severity == rule.Severity() {
+		return rule
+	}
+	return &profileRule{Rule: rule, severity: severity}
+}
+
+// profileRule overrides the severity of a rule
+type profileRule struct {
+	tflint.Rule
+
+	severity tflint.Severity
+}
+
+// Severity returns the severity set by the profile
+func (r *profileRule) Severity() tflint.Severity {
+	return r.severity
+}
+
+// complianceRunner appends the compliance controls of the rule to the issue messages
+type complianceRunner struct {
+	tflint.Runner
+}
+
+// EmitIssue reports an issue with the compliance controls of the rule
+func (r *complianceRunner) EmitIssue(rule tflint.Rule, message string, issueRange hcl.Range) error {
+	return r.Runner.EmitIssue(rule, complianceMessage(rule, message), issueRange)
+}
+
+// EmitIssueWithFix reports an issue with the compliance controls of the rule and applies its fix
+func (r *complianceRunner) EmitIssueWithFix(rule tflint.Rule, message string, issueRange hcl.Range, fixFunc func(f tflint.Fixer) error) error {
+	return r.Runner.EmitIssueWithFix(rule, complianceMessage(rule, message), issueRange, fixFunc)
+}
+
+func complianceMessage(rule tflint.Rule, message string) string {
+	controls, ok := project.ComplianceControls(rule.Name())
+	if !ok {
+		return message
+	}
+	return fmt.Sprintf("%s [%s]", message, controls)
+}
@@ -0,0 +1,545 @@
+// Command genrule scaffolds a rule that checks an attribute of an azurerm resource.
+//
+// It adds an AttributeRuleSpec entry to the spec file of the resource type in the rules directory,
+// declaring and registering the specs of the resource type in attributeRuleSpecs when the file has none,
+// writes the test of the rule to the rules directory and its documentation to docs/rules, and adds the
+// compliance controls of the rule to project/compliance.go.
+//
+// Usage:
+//
+//	go run ./tools/genrule -resource azurerm_redis_cache -attribute non_ssl_port_enabled -expected false -mcsb DP-3 -nist SC-8
+//	go run ./tools/genrule -resource azurerm_linux_web_app -attribute site_config.http2_enabled -expected true -severity notice -mcsb NS-8
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// rule describes the rule to generate
+type rule struct {
+	Name          string
+	TypeName      string
+	ResourceType  string
+	AttributePath []string
+	Expected      string
+	Invalid       string
+	Severity      string
+	Why           string
+	// Controls are the control IDs of the rule, keyed by compliance framework
+	Controls map[string][]string
+}
+
+// BlockTypes returns the nested block types leading to the attribute
+func (r *rule) BlockTypes() []string {
+	return r.AttributePath[:len(r.AttributePath)-1]
+}
+
+// Attribute returns the name of the checked attribute
+func (r *rule) Attribute() string {
+	return r.AttributePath[len(r.AttributePath)-1]
+}
+
+// IsBool returns whether the attribute is a boolean
+func (r *rule) IsBool() bool {
+	return r.Expected == "true" || r.Expected == "false"
+}
+
+// Literal returns the HCL literal of the value
+func (r *rule) Literal(value string) string {
+	if r.IsBool() {
+		return value
+	}
+	return fmt.Sprintf("%q", value)
+}
+
+// SpecName returns the rule name without the resource type prefix, the Name of its AttributeRuleSpec
+func (r *rule) SpecName() string {
+	return strings.TrimPrefix(r.Name, r.ResourceType+"_")
+}
+
+// SpecFile returns the name of the file declaring the attribute rule specs of the resource type, such as redis_cache.go
+func (r *rule) SpecFile() string {
+	return strings.TrimPrefix(r.ResourceType, "azurerm_") + ".go"
+}
+
+// SpecsVariable returns the name of the variable holding the attribute rule specs of the resource type,
+// such as redisCacheRuleSpecs
+func (r *rule) SpecsVariable() string {
+	name := typeName(strings.TrimPrefix(r.ResourceType, "azurerm_"))
+	return strings.ToLower(name[:1]) + name[1:] + "RuleSpecs"
+}
+
+// Body returns the body of a resource setting the attribute to the given HCL literal
+func (r *rule) Body(literal string) string {
+	var lines []string
+	for i, blockType := range r.BlockTypes() {
+		lines = append(lines, strings.Repeat("    ", i+1)+blockType+" {")
+	}
+	lines = append(lines, strings.Repeat("    ", len(r.AttributePath))+r.Attribute()+" = "+literal)
+	for i := len(r.BlockTypes()) - 1; i >= 0; i-- {
+		lines = append(lines, strings.Repeat("    ", i+1)+"}")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ValueRange returns the line and columns of the attribute value in the resource body built by Body
+func (r *rule) ValueRange(literal string) (line, start, end int) {
+	line = 2 + len(r.AttributePath)
+	start = 4*len(r.AttributePath) + len(r.Attribute()) + len(" = ") + 1
+	return line, start, start + len(literal)
+}
+
+// DefRangeEnd returns the column ending the `resource "<type>" "example"` header
+func (r *rule) DefRangeEnd() int {
+	return len(fmt.Sprintf(`resource "%s" "example"`, r.ResourceType)) + 1
+}
+
+// initialisms are rule name words spelled in upper case in Go identifiers
+var initialisms = map[string]string{
+	"aad":   "AAD",
+	"acl":   "ACL",
+	"cmk":   "CMK",
+	"http":  "HTTP",
+	"https": "HTTPS",
+	"id":    "ID",
+	"ip":    "IP",
+	"nsg":   "NSG",
+	"sas":   "SAS",
+	"scm":   "SCM",
+	"sql":   "SQL",
+	"ssl":   "SSL",
+	"tde":   "TDE",
+	"tls":   "TLS",
+}
+
+// typeName returns the Go type name of a rule name, such as AzurermRedisCacheNonSSLPortEnabled
+func typeName(name string) string {
+	var b strings.Builder
+	for _, word := range strings.Split(name, "_") {
+		if word == "" {
+			continue
+		}
+		if initialism, ok := initialisms[word]; ok {
+			b.WriteString(initialism)
+			continue
+		}
+		b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	return b.String()
+}
+
+var severities = map[string]string{
+	"error":   "ERROR",
+	"warning": "WARNING",
+	"notice":  "NOTICE",
+}
+
+// severityTitles are the severities as written in the documentation
+var severityTitles = map[string]string{
+	"ERROR":   "Error",
+	"WARNING": "Warning",
+	"NOTICE":  "Notice",
+}
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "genrule: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	flags := flag.NewFlagSet("genrule", flag.ContinueOnError)
+	resourceType := flags.String("resource", "", "resource type, such as azurerm_redis_cache")
+	attribute := flags.String("attribute", "", "attribute path, nested blocks separated by dots, such as site_config.ftps_state")
+	expected := flags.String("expected", "", "expected value of the attribute, true and false are checked as booleans")
+	invalid := flags.String("invalid", "", "value used by the failing test case, defaults to the opposite boolean or \"invalid\"")
+	severity := flags.String("severity", "warning", "rule severity: error, warning or notice")
+	name := flags.String("name", "", "rule name, defaults to <resource>_<attribute>")
+	why := flags.String("why", "", "explanation rendered in the Why section of the documentation")
+	cis := flags.String("cis", "", "comma-separated CIS Microsoft Azure Foundations Benchmark controls, such as 3.1")
+	mcsb := flags.String("mcsb", "", "comma-separated Microsoft Cloud Security Benchmark controls, such as DP-3")
+	nist := flags.String("nist", "", "comma-separated NIST SP 800-53 controls, such as SC-8")
+	root := flags.String("root", ".", "repository root")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	r, err := newRule(*resourceType, *attribute, *expected, *invalid, *severity, *name, *why)
+	if err != nil {
+		return err
+	}
+	r.Controls, err = newControls(*cis, *mcsb, *nist)
+	if err != nil {
+		return err
+	}
+	return generate(*root, r)
+}
+
+// newRule validates the flags and returns the rule to generate
+func newRule(resourceType, attribute, expected, invalid, severity, name, why string) (*rule, error) {
+	if !strings.HasPrefix(resourceType, "azurerm_") {
+		return nil, errors.New("-resource must be an azurerm resource type")
+	}
+	if attribute == "" {
+		return nil, errors.New("-attribute is required")
+	}
+	if expected == "" {
+		return nil, errors.New("-expected is required")
+	}
+	severityName, ok := severities[strings.ToLower(severity)]
+	if !ok {
+		return nil, fmt.Errorf("unknown severity %q, expected error, warning or notice", severity)
+	}
+
+	path := strings.Split(attribute, ".")
+	if name == "" {
+		name = resourceType + "_" + path[len(path)-1]
+	}
+	if !strings.HasPrefix(name, resourceType+"_") {
+		return nil, fmt.Errorf("-name must start with %s_", resourceType)
+	}
+
+	r := &rule{
+		Name:          name,
+		TypeName:      typeName(name),
+		ResourceType:  resourceType,
+		AttributePath: path,
+		Expected:      expected,
+		Invalid:       invalid,
+		Severity:      severityName,
+		Why:           why,
+	}
+	if r.Invalid == "" {
+		switch {
+		case expected == "true":
+			r.Invalid = "false"
+		case expected == "false":
+			r.Invalid = "true"
+		default:
+			r.Invalid = "invalid"
+		}
+	}
+	if r.Why == "" {
+		r.Why = fmt.Sprintf("Setting %s to %s on %s follows the Azure security baseline for this resource.", r.Attribute(), expected, resourceType)
+	}
+	return r, nil
+}
+
+// newControls parses the comma-separated control IDs of every framework
+func newControls(cis, mcsb, nist string) (map[string][]string, error) {
+	controls := map[string][]string{}
+	for framework, ids := range map[string]string{"CIS": cis, "MCSB": mcsb, "NIST": nist} {
+		for _, id := range strings.Split(ids, ",") {
+			if id = strings.TrimSpace(id); id != "" {
+				controls[framework] = append(controls[framework], id)
+			}
+		}
+	}
+	if len(controls) == 0 {
+		return nil, errors.New("at least one of -cis, -mcsb or -nist is required")
+	}
+	return controls, nil
+}
+
+// generate adds the spec of the rule, writes its test and documentation and maps its controls
+func generate(root string, r *rule) error {
+	testPath := filepath.Join(root, "rules", r.Name+"_test.go")
+	docsDir := filepath.Join(root, "docs", "rules")
+	docPath := filepath.Join(docsDir, r.Name+".md")
+	for _, path := range []string{testPath, docPath} {
+		if _, err := os.Stat(path); err == nil {
+			return fmt.Errorf("%s already exists", path)
+		}
+	}
+
+	specPath := filepath.Join(root, "rules", r.SpecFile())
+	specSrc, err := os.ReadFile(specPath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	specSrc, declared, err := addSpec(specSrc, r)
+	if err != nil {
+		return err
+	}
+	if declared {
+		attributeRulePath := filepath.Join(root, "rules", "attribute_rule.go")
+		attributeRuleSrc, err := os.ReadFile(attributeRulePath)
+		if err != nil {
+			return err
+		}
+		attributeRuleSrc, err = registerSpecs(attributeRuleSrc, r.SpecsVariable())
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(attributeRulePath, attributeRuleSrc, 0644); err != nil {
+			return err
+		}
+	}
+	if err := os.WriteFile(specPath, specSrc, 0644); err != nil {
+		return err
+	}
+
+	testSrc, err := renderTest(r)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(testPath, testSrc, 0644); err != nil {
+		return err
+	}
+
+	docTemplate, err := os.ReadFile(filepath.Join(docsDir, "template.md"))
+	if err != nil {
+		return err
+	}
+	doc, err := renderDoc(string(docTemplate), r)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(docPath, []byte(doc), 0644); err != nil {
+		return err
+	}
+
+	compliancePath := filepath.Join(root, "project", "compliance.go")
+	complianceSrc, err := os.ReadFile(compliancePath)
+	if err != nil {
+		return err
+	}
+	complianceSrc, err = addCompliance(complianceSrc, r)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(compliancePath, complianceSrc, 0644)
+}
+
+func renderTest(r *rule) ([]byte, error) {
+	src, err := render(testTemplate, r)
+	if err != nil {
+		return nil, err
+	}
+	return format.Source(src)
+}
+
+// render executes the template, which may use the spec and specs templates
+func render(text string, r *rule) ([]byte, error) {
+	tmpl, err := template.New(r.Name).Funcs(template.FuncMap{
+		"valueRange": func(literal string) map[string]int {
+			line, start, end := r.ValueRange(literal)
+			return map[string]int{"Line": line, "Start": start, "End": end}
+		},
+		"quote": func(s string) string { return fmt.Sprintf("%q", s) },
+	}).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tmpl.New("spec").Parse(specTemplate); err != nil {
+		return nil, err
+	}
+	if _, err := tmpl.New("specs").Parse(specsTemplate); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, r); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// addSpec adds the spec of the rule to the specs of the resource type in the spec file, which is nil when it does not exist.
+// It returns whether the specs variable was declared, and has to be registered in attributeRuleSpecs.
+func addSpec(src []byte, r *rule) ([]byte, bool, error) {
+	if src == nil {
+		out, err := render(specFileTemplate, r)
+		if err != nil {
+			return nil, false, err
+		}
+		out, err = format.Source(out)
+		return out, true, err
+	}
+
+	if regexp.MustCompile(fmt.Sprintf(`Name:\s+%q,`, regexp.QuoteMeta(r.SpecName()))).Match(src) {
+		return nil, false, fmt.Errorf("%s already declares a %s spec", r.SpecFile(), r.SpecName())
+	}
+
+	specs := regexp.MustCompile(fmt.Sprintf(`(?s)\nvar %s = \[\]\*AttributeRuleSpec\{\n.*?\n\}\n`, r.SpecsVariable()))
+	if location := specs.FindIndex(src); location != nil {
+		spec, err := render(specTemplate, r)
+		if err != nil {
+			return nil, false, err
+		}
+		// Insert the entry before the closing brace of the slice
+		end := location[1] - len("}\n")
+		var out bytes.Buffer
+		out.Write(src[:end])
+		out.Write(spec)
+		out.Write(src[end:])
+		formatted, err := format.Source(out.Bytes())
+		return formatted, false, err
+	}
+
+	declaration, err := render(specsTemplate, r)
+	if err != nil {
+		return nil, false, err
+	}
+	src, err = addImports(append(src, declaration...), "github.com/terraform-linters/tflint-plugin-sdk/tflint", "github.com/zclconf/go-cty/cty")
+	if err != nil {
+		return nil, false, err
+	}
+	formatted, err := format.Source(src)
+	return formatted, true, err
+}
+
+var importsPattern = regexp.MustCompile(`(?s)\nimport \(\n(.*?)\n\)\n`)
+
+// modulePath is the import path of the module, whose packages are imported in their own group
+const modulePath = "github.com/terraform-linters/tflint-ruleset-azurerm-security"
+
+// addImports adds the missing import paths to the group of third-party imports of the import block,
+// or in their own group when there is none, go/format sorts them
+func addImports(src []byte, paths ...string) ([]byte, error) {
+	location := importsPattern.FindSubmatchIndex(src)
+	if location == nil {
+		return nil, errors.New("no import block found")
+	}
+	lines := strings.Split(string(src[location[2]:location[3]]), "\n")
+
+	missing := []string{}
+	for _, path := range paths {
+		if !slices.Contains(lines, fmt.Sprintf("\t%q", path)) {
+			missing = append(missing, fmt.Sprintf("\t%q", path))
+		}
+	}
+	if len(missing) == 0 {
+		return src, nil
+	}
+
+	// Insert after the last third-party import, or in a new group at the end of the block
+	insert := -1
+	for i, line := range lines {
+		path := strings.Trim(strings.TrimSpace(line), `"`)
+		if strings.Contains(strings.SplitN(path, "/", 2)[0], ".") && !strings.HasPrefix(path, modulePath) {
+			insert = i + 1
+		}
+	}
+	if insert < 0 {
+		insert = len(lines)
+		missing = append([]string{""}, missing...)
+	}
+	lines = slices.Concat(lines[:insert], missing, lines[insert:])
+
+	var out bytes.Buffer
+	out.Write(src[:location[2]])
+	out.WriteString(strings.Join(lines, "\n"))
+	out.Write(src[location[3]:])
+	return out.Bytes(), nil
+}
+
+// renderDoc fills the documentation template, replacing its TODO markers by the example, the explanation and the fix
+func renderDoc(text string, r *rule) (string, error) {
+	text = strings.NewReplacer(
+		"{{rule_name}}", r.Name,
+		"{{severity}}", severityTitles[r.Severity],
+		"{{resource_name}}", r.ResourceType,
+	).Replace(text)
+
+	for _, replacement := range []string{r.Body(r.Literal(r.Invalid)), r.Why, r.Body(r.Literal(r.Expected))} {
+		todo := strings.Index(text, "TODO")
+		if todo < 0 {
+			return "", errors.New("docs/rules/template.md must contain the example, why and fix TODO markers")
+		}
+		start := todo
+		// Resource bodies carry their own indentation
+		if strings.HasPrefix(replacement, " ") {
+			start = strings.LastIndex(text[:todo], "\n") + 1
+		}
+		text = text[:start] + replacement + text[todo+len("TODO"):]
+	}
+	if strings.Contains(text, "TODO") {
+		return "", errors.New("docs/rules/template.md contains unexpected TODO markers")
+	}
+	return text, nil
+}
+
+var specsRegistrationPattern = regexp.MustCompile(`(?s)var attributeRuleSpecs = slices\.Concat\(\n(.*?)\n\)\n`)
+
+// registerSpecs adds the specs variable to attributeRuleSpecs, one variable per line, sorted case-insensitively
+func registerSpecs(src []byte, variable string) ([]byte, error) {
+	location := specsRegistrationPattern.FindSubmatchIndex(src)
+	if location == nil {
+		return nil, errors.New("no attributeRuleSpecs declaration found in rules/attribute_rule.go")
+	}
+	start, end := location[2], location[3]
+
+	lines := strings.Split(string(src[start:end]), "\n")
+	registration := fmt.Sprintf("\t%s,", variable)
+	for _, line := range lines {
+		if line == registration {
+			return nil, fmt.Errorf("%s is already registered", variable)
+		}
+	}
+	lines = append(lines, registration)
+	sort.SliceStable(lines, func(i, j int) bool {
+		return strings.ToLower(lines[i]) < strings.ToLower(lines[j])
+	})
+
+	var out bytes.Buffer
+	out.Write(src[:start])
+	out.WriteString(strings.Join(lines, "\n"))
+	out.Write(src[end:])
+	return out.Bytes(), nil
+}
+
+var compliancePattern = regexp.MustCompile(`(?s)var compliance = map\[string\]Controls\{\n(.*?)\n\}\n`)
+
+// addCompliance adds the controls of the rule to the compliance map, keeping it sorted by rule name
+func addCompliance(src []byte, r *rule) ([]byte, error) {
+	location := compliancePattern.FindSubmatchIndex(src)
+	if location == nil {
+		return nil, errors.New("no compliance map found in project/compliance.go")
+	}
+	start, end := location[2], location[3]
+
+	fields := []string{}
+	for _, framework := range []string{"CIS", "MCSB", "NIST"} {
+		if ids := r.Controls[framework]; len(ids) > 0 {
+			quoted := make([]string, len(ids))
+			for i, id := range ids {
+				quoted[i] = fmt.Sprintf("%q", id)
+			}
+			fields = append(fields, fmt.Sprintf("%s: []string{%s}", framework, strings.Join(quoted, ", ")))
+		}
+	}
+	entry := fmt.Sprintf("\t%q: {%s},", r.Name, strings.Join(fields, ", "))
+
+	lines := []string{}
+	if start < end {
+		lines = strings.Split(string(src[start:end]), "\n")
+	}
+	for _, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), fmt.Sprintf("%q:", r.Name)) {
+			return nil, fmt.Errorf("%s already has a compliance mapping", r.Name)
+		}
+	}
+	lines = append(lines, entry)
+	sort.SliceStable(lines, func(i, j int) bool {
+		return strings.TrimSpace(lines[i]) < strings.TrimSpace(lines[j])
+	})
+
+	var out bytes.Buffer
+	out.Write(src[:start])
+	out.WriteString(strings.Join(lines, "\n"))
+	out.Write(src[end:])
+	return format.Source(out.Bytes())
+}
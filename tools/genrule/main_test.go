@@ -0,0 +1,328 @@
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestTypeName(t *testing.T) {
+	tests := map[string]string{
+		"azurerm_redis_cache_non_ssl_port_enabled":      "AzurermRedisCacheNonSSLPortEnabled",
+		"azurerm_linux_web_app_https_only":              "AzurermLinuxWebAppHTTPSOnly",
+		"azurerm_mssql_server_minimum_tls_version":      "AzurermMssqlServerMinimumTLSVersion",
+		"azurerm_storage_account_public_network_access": "AzurermStorageAccountPublicNetworkAccess",
+	}
+
+	for name, expected := range tests {
+		if actual := typeName(name); actual != expected {
+			t.Errorf("typeName(%s) = %s, expected %s", name, actual, expected)
+		}
+	}
+}
+
+func TestNewRuleInvalid(t *testing.T) {
+	tests := []struct {
+		Name         string
+		ResourceType string
+		Attribute    string
+		Expected     string
+		Severity     string
+		RuleName     string
+	}{
+		{Name: "not an azurerm resource", ResourceType: "aws_s3_bucket", Attribute: "acl", Expected: "private", Severity: "warning"},
+		{Name: "missing attribute", ResourceType: "azurerm_redis_cache", Expected: "false", Severity: "warning"},
+		{Name: "missing expected value", ResourceType: "azurerm_redis_cache", Attribute: "non_ssl_port_enabled", Severity: "warning"},
+		{Name: "unknown severity", ResourceType: "azurerm_redis_cache", Attribute: "non_ssl_port_enabled", Expected: "false", Severity: "critical"},
+		{Name: "name of another resource type", ResourceType: "azurerm_redis_cache", Attribute: "non_ssl_port_enabled", Expected: "false", Severity: "warning", RuleName: "azurerm_storage_account_non_ssl_port_enabled"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			if _, err := newRule(test.ResourceType, test.Attribute, test.Expected, "", test.Severity, test.RuleName, ""); err == nil {
+				t.Fatal("Expected an error, got none")
+			}
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	for _, attribute := range []string{"non_ssl_port_enabled", "site_config.http2_enabled"} {
+		t.Run(attribute, func(t *testing.T) {
+			r, err := newRule("azurerm_linux_web_app", attribute, "true", "", "notice", "", "")
+			if err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			// Generated sources are formatted by go/format, which fails on invalid Go
+			if _, _, err := addSpec(nil, r); err != nil {
+				t.Fatalf("Failed to render spec: %s", err)
+			}
+			if _, err := renderTest(r); err != nil {
+				t.Fatalf("Failed to render test: %s", err)
+			}
+
+			template, err := os.ReadFile("../../docs/rules/template.md")
+			if err != nil {
+				t.Fatalf("Failed to read template file: %s", err)
+			}
+			doc, err := renderDoc(string(template), r)
+			if err != nil {
+				t.Fatalf("Failed to render documentation: %s", err)
+			}
+			if strings.Contains(doc, "{{") {
+				t.Errorf("Documentation still contains placeholders:\n%s", doc)
+			}
+			if !strings.Contains(doc, "**Severity:** Notice") {
+				t.Errorf("Documentation does not contain the severity:\n%s", doc)
+			}
+		})
+	}
+}
+
+func TestValueRange(t *testing.T) {
+	r, err := newRule("azurerm_linux_web_app", "site_config.ftps_state", "Disabled", "", "warning", "", "")
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	// `        ftps_state = "invalid"` on line 4
+	line, start, end := r.ValueRange(r.Literal(r.Invalid))
+	if line != 4 || start != 22 || end != 31 {
+		t.Errorf("ValueRange() = %d:%d-%d, expected 4:22-31", line, start, end)
+	}
+}
+
+func TestAddSpec(t *testing.T) {
+	r, err := newRule("azurerm_redis_cache", "non_ssl_port_enabled", "false", "", "warning", "", "")
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	spec := `	{
+		Name:          "non_ssl_port_enabled",
+		ResourceTypes: []string{"azurerm_redis_cache"},
+		AttributePath: []string{"non_ssl_port_enabled"},
+		Type:          cty.Bool,
+		Expected:      []string{"false"},
+		Enabled:       true,
+		Severity:      tflint.WARNING,
+		Messages: AttributeRuleMessages{
+			MissingAttribute: "non_ssl_port_enabled is not defined and should be false",
+			InvalidValue:     "non_ssl_port_enabled should be false",
+		},
+	},
+`
+
+	tests := []struct {
+		Name     string
+		Src      string
+		Expected string
+		Declared bool
+	}{
+		{
+			Name: "new spec file",
+			Expected: `package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// redisCacheRuleSpecs declares the attribute rules of azurerm_redis_cache
+var redisCacheRuleSpecs = []*AttributeRuleSpec{
+` + spec + `}
+`,
+			Declared: true,
+		},
+		{
+			Name: "specs of the resource type",
+			Src: `package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var redisCacheRuleSpecs = []*AttributeRuleSpec{
+	{
+		Name: "public_network_access_enabled",
+	},
+}
+
+func other() {
+}
+`,
+			Expected: `package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var redisCacheRuleSpecs = []*AttributeRuleSpec{
+	{
+		Name: "public_network_access_enabled",
+	},
+` + spec + `}
+
+func other() {
+}
+`,
+		},
+		{
+			Name: "spec file without specs importing packages of the module",
+			Src: `package rules
+
+import (
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+)
+`,
+			Expected: `package rules
+
+import (
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/terraform-linters/tflint-ruleset-azurerm-security/project"
+)
+
+// redisCacheRuleSpecs declares the attribute rules of azurerm_redis_cache
+var redisCacheRuleSpecs = []*AttributeRuleSpec{
+` + spec + `}
+`,
+			Declared: true,
+		},
+		{
+			Name: "spec file without specs",
+			Src: `package rules
+
+import (
+	"strings"
+)
+
+func other() {
+}
+`,
+			Expected: `package rules
+
+import (
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func other() {
+}
+
+// redisCacheRuleSpecs declares the attribute rules of azurerm_redis_cache
+var redisCacheRuleSpecs = []*AttributeRuleSpec{
+` + spec + `}
+`,
+			Declared: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var src []byte
+			if test.Src != "" {
+				src = []byte(test.Src)
+			}
+			actual, declared, err := addSpec(src, r)
+			if err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+			if string(actual) != test.Expected {
+				t.Errorf("Unexpected spec file:\n%s", actual)
+			}
+			if declared != test.Declared {
+				t.Errorf("Expected declared to be %t, got %t", test.Declared, declared)
+			}
+
+			if _, _, err := addSpec(actual, r); err == nil {
+				t.Error("Expected an error adding a spec twice, got none")
+			}
+		})
+	}
+}
+
+func TestRegisterSpecs(t *testing.T) {
+	src := `package rules
+
+var attributeRuleSpecs = slices.Concat(
+	appServiceRuleSpecs,
+	storageAccountRuleSpecs,
+)
+`
+	expected := `package rules
+
+var attributeRuleSpecs = slices.Concat(
+	appServiceRuleSpecs,
+	redisCacheRuleSpecs,
+	storageAccountRuleSpecs,
+)
+`
+
+	actual, err := registerSpecs([]byte(src), "redisCacheRuleSpecs")
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if string(actual) != expected {
+		t.Errorf("Unexpected rules/attribute_rule.go:\n%s", actual)
+	}
+
+	if _, err := registerSpecs(actual, "redisCacheRuleSpecs"); err == nil {
+		t.Error("Expected an error registering specs twice, got none")
+	}
+}
+
+func TestAddCompliance(t *testing.T) {
+	src := `package project
+
+var compliance = map[string]Controls{
+	"azurerm_key_vault_enable_rbac_authorization": {CIS: []string{"8.6"}, MCSB: []string{"PA-7"}, NIST: []string{"AC-3", "AC-6"}},
+	"azurerm_storage_account_unsecure_tls":        {CIS: []string{"3.15"}, MCSB: []string{"DP-3", "NS-8"}, NIST: []string{"SC-8", "SC-13"}},
+}
+`
+	expected := `package project
+
+var compliance = map[string]Controls{
+	"azurerm_key_vault_enable_rbac_authorization": {CIS: []string{"8.6"}, MCSB: []string{"PA-7"}, NIST: []string{"AC-3", "AC-6"}},
+	"azurerm_redis_cache_non_ssl_port_enabled":    {MCSB: []string{"DP-3"}, NIST: []string{"SC-8", "SC-8(1)"}},
+	"azurerm_storage_account_unsecure_tls":        {CIS: []string{"3.15"}, MCSB: []string{"DP-3", "NS-8"}, NIST: []string{"SC-8", "SC-13"}},
+}
+`
+
+	r, err := newRule("azurerm_redis_cache", "non_ssl_port_enabled", "false", "", "warning", "", "")
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	r.Controls, err = newControls("", "DP-3", "SC-8, SC-8(1)")
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	actual, err := addCompliance([]byte(src), r)
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if string(actual) != expected {
+		t.Errorf("Unexpected project/compliance.go:\n%s", actual)
+	}
+
+	if _, err := addCompliance(actual, r); err == nil {
+		t.Error("Expected an error mapping a rule twice, got none")
+	}
+	if _, err := newControls("", "", ""); err == nil {
+		t.Error("Expected an error without controls, got none")
+	}
+}
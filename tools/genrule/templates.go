@@ -0,0 +1,151 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Nested returns whether the attribute is nested in blocks
+func (r *rule) Nested() bool {
+	return len(r.AttributePath) > 1
+}
+
+// MissingMessage returns the message of the issue emitted when the attribute is not defined
+func (r *rule) MissingMessage() string {
+	return fmt.Sprintf("%s is not defined and should be %s", r.Attribute(), r.Expected)
+}
+
+// InvalidMessage returns the message of the issue emitted when the attribute is not set to the expected value
+func (r *rule) InvalidMessage() string {
+	return fmt.Sprintf("%s should be %s", r.Attribute(), r.Expected)
+}
+
+// CtyType returns the Go expression of the attribute type
+func (r *rule) CtyType() string {
+	if r.IsBool() {
+		return "cty.Bool"
+	}
+	return "cty.String"
+}
+
+// PathLiteral returns the Go literal of the attribute path
+func (r *rule) PathLiteral() string {
+	quoted := make([]string, len(r.AttributePath))
+	for i, element := range r.AttributePath {
+		quoted[i] = fmt.Sprintf("%q", element)
+	}
+	return "[]string{" + strings.Join(quoted, ", ") + "}"
+}
+
+// specTemplate is the AttributeRuleSpec entry of the rule
+const specTemplate = `	{
+		Name:          {{quote .SpecName}},
+		ResourceTypes: []string{ {{- quote .ResourceType -}} },
+		AttributePath: {{.PathLiteral}},
+		Type:          {{.CtyType}},
+		Expected:      []string{ {{- quote .Expected -}} },
+		Enabled:       true,
+		Severity:      tflint.{{.Severity}},
+		Messages: AttributeRuleMessages{
+{{- if .Nested}}
+			MissingBlock:     {{quote .MissingMessage}},
+{{- end}}
+			MissingAttribute: {{quote .MissingMessage}},
+			InvalidValue:     {{quote .InvalidMessage}},
+		},
+	},
+`
+
+// specsTemplate declares the attribute rule specs of a resource type, starting with the entry of the rule
+const specsTemplate = `
+// {{.SpecsVariable}} declares the attribute rules of {{.ResourceType}}
+var {{.SpecsVariable}} = []*AttributeRuleSpec{
+{{template "spec" .}}}
+`
+
+// specFileTemplate is the file declaring the attribute rule specs of a resource type
+const specFileTemplate = `package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+{{template "specs" .}}`
+
+const testTemplate = `package rules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func Test_{{.TypeName}}(t *testing.T) {
+	rule := findAttributeRule(t, {{quote .Name}})
+
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected helper.Issues
+	}{
+		{
+			Name: "{{.Attribute}} not set to {{.Expected}}",
+			Content: ` + "`" + `
+resource {{quote .ResourceType}} "example" {
+{{.Body (.Literal .Invalid)}}
+}` + "`" + `,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: {{quote .InvalidMessage}},
+					Range: hcl.Range{
+						Filename: "resource.tf",
+{{- with valueRange (.Literal .Invalid)}}
+						Start:    hcl.Pos{Line: {{.Line}}, Column: {{.Start}}},
+						End:      hcl.Pos{Line: {{.Line}}, Column: {{.End}}},
+{{- end}}
+					},
+				},
+			},
+		},
+		{
+			Name: "{{.Attribute}} missing",
+			Content: ` + "`" + `
+resource {{quote .ResourceType}} "example" {
+}` + "`" + `,
+			Expected: helper.Issues{
+				{
+					Rule:    rule,
+					Message: {{quote .MissingMessage}},
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: {{.DefRangeEnd}}},
+					},
+				},
+			},
+		},
+		{
+			Name: "{{.Attribute}} set to {{.Expected}}",
+			Content: ` + "`" + `
+resource {{quote .ResourceType}} "example" {
+{{.Body (.Literal .Expected)}}
+}` + "`" + `,
+			Expected: helper.Issues{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"resource.tf": test.Content})
+
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.Expected, runner.Issues)
+		})
+	}
+}
+`